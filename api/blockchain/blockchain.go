@@ -0,0 +1,25 @@
+package blockchain
+
+import (
+	"context"
+	"net/http"
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+type Blockchain struct {
+	executor Executor
+}
+
+func New(executor Executor) *Blockchain {
+	return &Blockchain{
+		executor: executor,
+	}
+}
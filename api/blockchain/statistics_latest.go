@@ -0,0 +1,87 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	statisticsLatestEndpoint = "/v1/blockchain/statistics/latest"
+)
+
+type StatisticsLatestResponse struct {
+	Data   map[string]StatisticsData `json:"data"`
+	Status types.Status              `json:"status"`
+}
+
+// GetStatus returns response status.
+func (s *StatisticsLatestResponse) GetStatus() types.Status {
+	return s.Status
+}
+
+// StatisticsData blockchain statistics.
+// Difficulty, hashrate and total transactions are returned as decimal strings
+// since their values may exceed float64 precision, parse them with math/big if needed.
+type StatisticsData struct {
+	ID                 int     `json:"id"`
+	Slug               string  `json:"slug"`
+	Symbol             string  `json:"symbol"`
+	BlockRewardStatic  float64 `json:"block_reward_static"`
+	ConsensusMechanism string  `json:"consensus_mechanism"`
+	// Difficulty current mining difficulty as decimal string.
+	Difficulty string `json:"difficulty"`
+	// Hashrate24h average hashrate over the last 24 hours as decimal string.
+	Hashrate24h         string `json:"hashrate_24h"`
+	PendingTransactions int    `json:"pending_transactions"`
+	ReductionRate       string `json:"reduction_rate"`
+	TotalBlocks         int    `json:"total_blocks"`
+	// TotalTransactions total number of transactions as decimal string.
+	TotalTransactions   string    `json:"total_transactions"`
+	TPS24h              float64   `json:"tps_24h"`
+	FirstBlockTimestamp time.Time `json:"first_block_timestamp"`
+}
+
+// StatisticsLatest returns the latest blockchain statistics data for 1 or more blockchains.
+// Bitcoin, Litecoin, and Ethereum are currently supported.
+// Currencies should be specified either all by id, all by symbol or all by slug.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1BlockchainStatisticsLatest
+func (b *Blockchain) StatisticsLatest(
+	ctx context.Context,
+	currencies []currency.Currency,
+) (*StatisticsLatestResponse, error) {
+	var response StatisticsLatestResponse
+
+	if err := b.executor.Get(
+		ctx,
+		statisticsLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeStatisticsLatestQuery(currencies)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeStatisticsLatestQuery(currencies []currency.Currency) string {
+	query := make(url.Values)
+	urlquery.AddCurrencies(query, currencies)
+
+	return query.Encode()
+}
@@ -0,0 +1,109 @@
+package blockchain_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/blockchain"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	statisticsLatestResponseBody = `
+{
+	"data": {
+		"BTC": {
+			"id": 1,
+			"slug": "bitcoin",
+			"symbol": "BTC",
+			"block_reward_static": 3.125,
+			"consensus_mechanism": "proof-of-work",
+			"difficulty": "116958512019762.1",
+			"hashrate_24h": "835384937215810987654.32",
+			"pending_transactions": 24873,
+			"reduction_rate": "50%",
+			"total_blocks": 903245,
+			"total_transactions": "1201235689",
+			"tps_24h": 4.28,
+			"first_block_timestamp": "2009-01-09T02:54:25.000Z"
+		}
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestStatisticsLatest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		currencies    []currency.Currency
+		expectedQuery string
+	}{
+		{
+			name:          "id",
+			currencies:    []currency.Currency{currency.ID(1), currency.ID(1027)},
+			expectedQuery: "id=1%2C1027",
+		},
+		{
+			name:          "symbol",
+			currencies:    []currency.Currency{currency.Symbol("BTC"), currency.Symbol("ETH")},
+			expectedQuery: "symbol=BTC%2CETH",
+		},
+		{
+			name:          "slug",
+			currencies:    []currency.Currency{currency.Slug("bitcoin"), currency.Slug("litecoin")},
+			expectedQuery: "slug=bitcoin%2Clitecoin",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctrl         = gomock.NewController(t)
+				mockExecutor = blockchain.NewMockExecutor(ctrl)
+				chain        = blockchain.New(mockExecutor)
+			)
+
+			mockExecutor.EXPECT().
+				Get(t.Context(), "/v1/blockchain/statistics/latest", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(
+					ctx context.Context,
+					path string,
+					preProcessFn func(req *http.Request) error,
+					result any,
+				) error {
+					require.Equal(t, tc.expectedQuery, querytest.RawQuery(t, ctx, preProcessFn))
+
+					return json.Unmarshal([]byte(statisticsLatestResponseBody), result)
+				})
+
+			stats, err := chain.StatisticsLatest(t.Context(), tc.currencies)
+			require.NoError(t, err)
+
+			btc := stats.Data["BTC"]
+			require.InDelta(t, 3.125, btc.BlockRewardStatic, 0)
+			require.Equal(t, "835384937215810987654.32", btc.Hashrate24h)
+			require.Equal(t, 24873, btc.PendingTransactions)
+			require.Equal(t, 903245, btc.TotalBlocks)
+			require.Equal(t, time.Date(2009, time.January, 9, 2, 54, 25, 0, time.UTC), btc.FirstBlockTimestamp)
+		})
+	}
+}
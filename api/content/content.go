@@ -0,0 +1,86 @@
+package content
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+type Content struct {
+	executor Executor
+}
+
+func New(executor Executor) *Content {
+	return &Content{
+		executor: executor,
+	}
+}
+
+// AssetLink cryptocurrency linked to the content item or post.
+type AssetLink struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+	Slug   string `json:"slug"`
+}
+
+// Author community post author.
+type Author struct {
+	Nickname  string `json:"nickname"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// Post community post or comment.
+type Post struct {
+	PostID       string      `json:"post_id"`
+	Author       Author      `json:"owner"`
+	TextContent  string      `json:"text_content"`
+	Photos       []string    `json:"photos"`
+	CommentCount int         `json:"comment_count,string"`
+	LikeCount    int         `json:"like_count,string"`
+	PostTime     time.Time   `json:"post_time"`
+	LanguageCode string      `json:"language_code"`
+	Assets       []AssetLink `json:"currencies"`
+}
+
+// UnmarshalJSON parses post time from unix milliseconds string.
+func (p *Post) UnmarshalJSON(data []byte) error {
+	type post Post
+
+	var raw struct {
+		post
+
+		PostTime string `json:"post_time"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("unmarshal post: %w", err)
+	}
+
+	*p = Post(raw.post)
+
+	if raw.PostTime == "" {
+		return nil
+	}
+
+	millis, err := strconv.ParseInt(raw.PostTime, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse post time: %w", err)
+	}
+
+	p.PostTime = time.UnixMilli(millis).UTC()
+
+	return nil
+}
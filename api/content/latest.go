@@ -0,0 +1,207 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	latestEndpoint = "/v1/content/latest"
+)
+
+type LatestResponse struct {
+	Data   []LatestData `json:"data"`
+	Status types.Status `json:"status"`
+}
+
+// GetStatus returns response status.
+func (l *LatestResponse) GetStatus() types.Status {
+	return l.Status
+}
+
+type LatestData struct {
+	Title      string      `json:"title"`
+	Subtitle   string      `json:"subtitle"`
+	Cover      string      `json:"cover"`
+	Type       NewsType    `json:"type"`
+	SourceName string      `json:"source_name"`
+	SourceURL  string      `json:"source_url"`
+	CreatedAt  time.Time   `json:"created_at"`
+	ReleasedAt time.Time   `json:"released_at"`
+	Assets     []AssetLink `json:"assets"`
+}
+
+type NewsType string
+
+func (n NewsType) String() string {
+	return string(n)
+}
+
+const (
+	NewsTypeAll        NewsType = "all"
+	NewsTypeNews       NewsType = "news"
+	NewsTypeCommunity  NewsType = "community"
+	NewsTypeAlexandria NewsType = "alexandria"
+)
+
+type ContentType string
+
+func (c ContentType) String() string {
+	return string(c)
+}
+
+const (
+	ContentTypeAll   ContentType = "all"
+	ContentTypeNews  ContentType = "news"
+	ContentTypeVideo ContentType = "video"
+	ContentTypeAudio ContentType = "audio"
+)
+
+type latestOptions struct {
+	Start       int
+	Limit       int
+	Currencies  []currency.Currency
+	NewsType    NewsType
+	ContentType ContentType
+	Category    string
+	Language    string
+}
+
+// LatestOption latest content optional param.
+type LatestOption func(opts *latestOptions)
+
+// WithLatestStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithLatestStart(start int) LatestOption {
+	return func(opts *latestOptions) {
+		opts.Start = start
+	}
+}
+
+// WithLatestLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+// Default 100.
+func WithLatestLimit(limit int) LatestOption {
+	return func(opts *latestOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithLatestCurrencies filter content by one or more cryptocurrencies.
+// Currencies should be specified either all by id, all by symbol or all by slug.
+func WithLatestCurrencies(currencies ...currency.Currency) LatestOption {
+	return func(opts *latestOptions) {
+		opts.Currencies = currencies
+	}
+}
+
+// WithLatestNewsType the type of news content to return.
+// Default "all".
+func WithLatestNewsType(newsType NewsType) LatestOption {
+	return func(opts *latestOptions) {
+		opts.NewsType = newsType
+	}
+}
+
+// WithLatestContentType the type of content to return.
+// Default "all".
+func WithLatestContentType(contentType ContentType) LatestOption {
+	return func(opts *latestOptions) {
+		opts.ContentType = contentType
+	}
+}
+
+// WithLatestCategory filter content by category.
+func WithLatestCategory(category string) LatestOption {
+	return func(opts *latestOptions) {
+		opts.Category = category
+	}
+}
+
+// WithLatestLanguage filter content by language code, for example "en".
+// Default "en".
+func WithLatestLanguage(language string) LatestOption {
+	return func(opts *latestOptions) {
+		opts.Language = language
+	}
+}
+
+// Latest returns a paginated list of content pulled from CMC News/Headlines and Alexandria articles.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ContentLatest
+func (c *Content) Latest(
+	ctx context.Context,
+	withOpts ...LatestOption,
+) (*LatestResponse, error) {
+	var (
+		options = latestOptions{
+			Start: 1,
+		}
+		response LatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		latestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeLatestQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeLatestQuery(options latestOptions) string {
+	query := make(url.Values)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	if len(options.Currencies) > 0 {
+		urlquery.AddCurrencies(query, options.Currencies)
+	}
+
+	if options.NewsType != "" {
+		query.Add("news_type", options.NewsType.String())
+	}
+
+	if options.ContentType != "" {
+		query.Add("content_type", options.ContentType.String())
+	}
+
+	if options.Category != "" {
+		query.Add("category", options.Category)
+	}
+
+	if options.Language != "" {
+		query.Add("language", options.Language)
+	}
+
+	return query.Encode()
+}
@@ -0,0 +1,56 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+const (
+	postsCommentsEndpoint = "/v1/content/posts/comments"
+)
+
+type PostsCommentsResponse struct {
+	Data   []Post       `json:"data"`
+	Status types.Status `json:"status"`
+}
+
+// GetStatus returns response status.
+func (p *PostsCommentsResponse) GetStatus() types.Status {
+	return p.Status
+}
+
+// PostsComments returns comments of the community post.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ContentPostsComments
+func (c *Content) PostsComments(
+	ctx context.Context,
+	postID string,
+) (*PostsCommentsResponse, error) {
+	var response PostsCommentsResponse
+
+	if err := c.executor.Get(
+		ctx,
+		postsCommentsEndpoint,
+		func(req *http.Request) error {
+			query := make(url.Values)
+			query.Add("post_id", postID)
+
+			req.URL.RawQuery = query.Encode()
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
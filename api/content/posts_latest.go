@@ -0,0 +1,93 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	postsLatestEndpoint = "/v1/content/posts/latest"
+)
+
+type PostsLatestResponse struct {
+	Data   PostsLatestData `json:"data"`
+	Status types.Status    `json:"status"`
+}
+
+// GetStatus returns response status.
+func (p *PostsLatestResponse) GetStatus() types.Status {
+	return p.Status
+}
+
+type PostsLatestData struct {
+	Posts  []Post `json:"list"`
+	LastID string `json:"last_id"`
+}
+
+type postsLatestOptions struct {
+	LastID string
+}
+
+// PostsLatestOption posts latest optional param.
+type PostsLatestOption func(opts *postsLatestOptions)
+
+// WithPostsLatestLastID pagination cursor, pass "last_id" returned with the previous page.
+func WithPostsLatestLastID(lastID string) PostsLatestOption {
+	return func(opts *postsLatestOptions) {
+		opts.LastID = lastID
+	}
+}
+
+// PostsLatest returns a paginated list of latest community posts for a cryptocurrency.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ContentPostsLatest
+func (c *Content) PostsLatest(
+	ctx context.Context,
+	curr currency.Currency,
+	withOpts ...PostsLatestOption,
+) (*PostsLatestResponse, error) {
+	var (
+		options  postsLatestOptions
+		response PostsLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		postsLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makePostsLatestQuery(curr, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makePostsLatestQuery(curr currency.Currency, options postsLatestOptions) string {
+	query := make(url.Values)
+	urlquery.AddCurrencies(query, []currency.Currency{curr})
+
+	if options.LastID != "" {
+		query.Add("last_id", options.LastID)
+	}
+
+	return query.Encode()
+}
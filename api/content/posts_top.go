@@ -0,0 +1,93 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	postsTopEndpoint = "/v1/content/posts/top"
+)
+
+type PostsTopResponse struct {
+	Data   PostsTopData `json:"data"`
+	Status types.Status `json:"status"`
+}
+
+// GetStatus returns response status.
+func (p *PostsTopResponse) GetStatus() types.Status {
+	return p.Status
+}
+
+type PostsTopData struct {
+	Posts     []Post `json:"list"`
+	LastScore string `json:"last_score"`
+}
+
+type postsTopOptions struct {
+	LastScore string
+}
+
+// PostsTopOption posts top optional param.
+type PostsTopOption func(opts *postsTopOptions)
+
+// WithPostsTopLastScore pagination cursor, pass "last_score" returned with the previous page.
+func WithPostsTopLastScore(score string) PostsTopOption {
+	return func(opts *postsTopOptions) {
+		opts.LastScore = score
+	}
+}
+
+// PostsTop returns a paginated list of top community posts for a cryptocurrency.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ContentPostsTop
+func (c *Content) PostsTop(
+	ctx context.Context,
+	curr currency.Currency,
+	withOpts ...PostsTopOption,
+) (*PostsTopResponse, error) {
+	var (
+		options  postsTopOptions
+		response PostsTopResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		postsTopEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makePostsTopQuery(curr, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makePostsTopQuery(curr currency.Currency, options postsTopOptions) string {
+	query := make(url.Values)
+	urlquery.AddCurrencies(query, []currency.Currency{curr})
+
+	if options.LastScore != "" {
+		query.Add("last_score", options.LastScore)
+	}
+
+	return query.Encode()
+}
@@ -0,0 +1,100 @@
+package content_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/content"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+const (
+	postsTopResponseBody = `
+{
+	"data": {
+		"list": [
+			{
+				"post_id": "325670123",
+				"owner": {
+					"nickname": "CoinMarketCap",
+					"avatar_url": "https://s3.coinmarketcap.com/static/img/portraits/avatar.png"
+				},
+				"text_content": "$BTC to the moon",
+				"photos": [],
+				"comment_count": "3",
+				"like_count": "12",
+				"post_time": "1751127588000",
+				"language_code": "en",
+				"currencies": [
+					{
+						"id": 1,
+						"symbol": "BTC",
+						"slug": "bitcoin"
+					}
+				]
+			}
+		],
+		"last_score": "380055294"
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 0,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestPostsTop(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = content.NewMockExecutor(ctrl)
+		cont         = content.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/content/posts/top", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			require.NoError(t, preProcessFn(req))
+
+			require.Equal(t, "last_score=380055200&symbol=BTC", req.URL.RawQuery)
+
+			return json.Unmarshal([]byte(postsTopResponseBody), result)
+		})
+
+	posts, err := cont.PostsTop(
+		t.Context(),
+		currency.Symbol("BTC"),
+		content.WithPostsTopLastScore("380055200"),
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, "380055294", posts.Data.LastScore)
+	require.Len(t, posts.Data.Posts, 1)
+
+	post := posts.Data.Posts[0]
+	require.Equal(t, "325670123", post.PostID)
+	require.Equal(t, "CoinMarketCap", post.Author.Nickname)
+	require.Equal(t, 3, post.CommentCount)
+	require.Equal(t, 12, post.LikeCount)
+	require.Equal(t, time.Date(2025, time.June, 28, 16, 19, 48, 0, time.UTC), post.PostTime)
+	require.Equal(t, []content.AssetLink{{ID: 1, Symbol: "BTC", Slug: "bitcoin"}}, post.Assets)
+}
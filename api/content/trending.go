@@ -0,0 +1,137 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+const (
+	trendingTopicsEndpoint = "/v1/community/trending/topic"
+	trendingTokensEndpoint = "/v1/community/trending/token"
+)
+
+type TrendingTopicsResponse struct {
+	Data   []TrendingTopic `json:"data"`
+	Status types.Status    `json:"status"`
+}
+
+// GetStatus returns response status.
+func (t *TrendingTopicsResponse) GetStatus() types.Status {
+	return t.Status
+}
+
+type TrendingTopic struct {
+	Rank  int    `json:"rank"`
+	Topic string `json:"topic"`
+}
+
+type TrendingTokensResponse struct {
+	Data   []TrendingToken `json:"data"`
+	Status types.Status    `json:"status"`
+}
+
+// GetStatus returns response status.
+func (t *TrendingTokensResponse) GetStatus() types.Status {
+	return t.Status
+}
+
+type TrendingToken struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Symbol  string `json:"symbol"`
+	CMCRank int    `json:"cmc_rank"`
+	Rank    int    `json:"rank"`
+}
+
+type trendingOptions struct {
+	Limit int
+}
+
+// TrendingOption community trending topics and tokens optional param.
+type TrendingOption func(opts *trendingOptions)
+
+// WithTrendingLimit specify the number of results to return.
+// Default 5.
+func WithTrendingLimit(limit int) TrendingOption {
+	return func(opts *trendingOptions) {
+		opts.Limit = limit
+	}
+}
+
+// TrendingTopics returns the latest trending topics in the CMC community.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CommunityTrendingTopic
+func (c *Content) TrendingTopics(
+	ctx context.Context,
+	withOpts ...TrendingOption,
+) (*TrendingTopicsResponse, error) {
+	var response TrendingTopicsResponse
+
+	if err := c.trending(ctx, trendingTopicsEndpoint, withOpts, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+// TrendingTokens returns the latest trending tokens in the CMC community.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CommunityTrendingToken
+func (c *Content) TrendingTokens(
+	ctx context.Context,
+	withOpts ...TrendingOption,
+) (*TrendingTokensResponse, error) {
+	var response TrendingTokensResponse
+
+	if err := c.trending(ctx, trendingTokensEndpoint, withOpts, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func (c *Content) trending(
+	ctx context.Context,
+	endpoint string,
+	withOpts []TrendingOption,
+	response any,
+) error {
+	var options trendingOptions
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		endpoint,
+		func(req *http.Request) error {
+			query := make(url.Values)
+
+			if options.Limit > 0 {
+				query.Add("limit", strconv.Itoa(options.Limit))
+			}
+
+			req.URL.RawQuery = query.Encode()
+
+			return nil
+		},
+		response,
+	); err != nil {
+		return fmt.Errorf("execute get request: %w", err)
+	}
+
+	return nil
+}
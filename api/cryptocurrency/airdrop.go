@@ -0,0 +1,60 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+const (
+	airdropEndpoint = "/v1/cryptocurrency/airdrop"
+)
+
+type AirdropResponse struct {
+	Data   AirdropData  `json:"data"`
+	Status types.Status `json:"status"`
+}
+
+// GetStatus returns response status.
+func (a *AirdropResponse) GetStatus() types.Status {
+	return a.Status
+}
+
+// Airdrop returns information about a single airdrop available on CoinMarketCap.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CryptocurrencyAirdrop
+func (c *Cryptocurrency) Airdrop(
+	ctx context.Context,
+	airdropID string,
+) (*AirdropResponse, error) {
+	var response AirdropResponse
+
+	if err := c.executor.Get(
+		ctx,
+		airdropEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeAirdropQuery(airdropID)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeAirdropQuery(airdropID string) string {
+	query := make(url.Values)
+	query.Add("id", airdropID)
+
+	return query.Encode()
+}
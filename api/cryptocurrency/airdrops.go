@@ -0,0 +1,161 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	airdropsEndpoint = "/v1/cryptocurrency/airdrops"
+)
+
+type AirdropsResponse struct {
+	Data   []AirdropData `json:"data"`
+	Status types.Status  `json:"status"`
+}
+
+// GetStatus returns response status.
+func (a *AirdropsResponse) GetStatus() types.Status {
+	return a.Status
+}
+
+type AirdropData struct {
+	ID          string        `json:"id"`
+	ProjectName string        `json:"project_name"`
+	Description string        `json:"description"`
+	Status      AirdropStatus `json:"status"`
+	Coin        AirdropCoin   `json:"coin"`
+	StartDate   time.Time     `json:"start_date"`
+	EndDate     time.Time     `json:"end_date"`
+	TotalPrize  float64       `json:"total_prize"`
+	WinnerCount int           `json:"winner_count"`
+	Link        string        `json:"link"`
+}
+
+type AirdropCoin struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Slug   string `json:"slug"`
+	Symbol string `json:"symbol"`
+}
+
+type AirdropStatus string
+
+func (a AirdropStatus) String() string {
+	return string(a)
+}
+
+const (
+	AirdropStatusOngoing  AirdropStatus = "ONGOING"
+	AirdropStatusUpcoming AirdropStatus = "UPCOMING"
+	AirdropStatusEnded    AirdropStatus = "ENDED"
+)
+
+type airdropsOptions struct {
+	Start      int
+	Limit      int
+	Status     AirdropStatus
+	Currencies []currency.Currency
+}
+
+// AirdropsOption airdrops optional param.
+type AirdropsOption func(opts *airdropsOptions)
+
+// WithAirdropsStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithAirdropsStart(start int) AirdropsOption {
+	return func(opts *airdropsOptions) {
+		opts.Start = start
+	}
+}
+
+// WithAirdropsLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+// Default 100.
+func WithAirdropsLimit(limit int) AirdropsOption {
+	return func(opts *airdropsOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithAirdropsStatus what status of airdrops.
+// Default "ONGOING".
+func WithAirdropsStatus(status AirdropStatus) AirdropsOption {
+	return func(opts *airdropsOptions) {
+		opts.Status = status
+	}
+}
+
+// WithAirdropsCurrencies filter airdrops by one or more cryptocurrencies.
+// Currencies should be specified either all by id, all by symbol or all by slug.
+func WithAirdropsCurrencies(currencies ...currency.Currency) AirdropsOption {
+	return func(opts *airdropsOptions) {
+		opts.Currencies = currencies
+	}
+}
+
+// Airdrops returns a list of past, present, or future airdrops which have run on CoinMarketCap.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CryptocurrencyAirdrops
+func (c *Cryptocurrency) Airdrops(
+	ctx context.Context,
+	withOpts ...AirdropsOption,
+) (*AirdropsResponse, error) {
+	var (
+		options = airdropsOptions{
+			Start: 1,
+		}
+		response AirdropsResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		airdropsEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeAirdropsQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeAirdropsQuery(options airdropsOptions) string {
+	query := make(url.Values)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	if options.Status != "" {
+		query.Add("status", options.Status.String())
+	}
+
+	urlquery.AddCurrencies(query, options.Currencies)
+
+	return query.Encode()
+}
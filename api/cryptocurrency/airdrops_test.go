@@ -0,0 +1,127 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	airdropsResponseBody = `
+{
+	"data": [
+		{
+			"id": "60e59e8d6bb8c4153ba4a4c7",
+			"project_name": "DeFi Land",
+			"description": "DeFi Land airdrop",
+			"status": "UPCOMING",
+			"coin": {
+				"id": 10042,
+				"name": "DeFi Land",
+				"slug": "defi-land",
+				"symbol": "DFL"
+			},
+			"start_date": "2025-07-01T00:00:00.000Z",
+			"end_date": "2025-07-15T00:00:00.000Z",
+			"total_prize": 50000,
+			"winner_count": 1000,
+			"link": "https://coinmarketcap.com/currencies/defi-land/airdrop/"
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestAirdrops(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/cryptocurrency/airdrops", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"limit=20&start=1&status=UPCOMING&symbol=DFL",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(airdropsResponseBody), result)
+		})
+
+	airdrops, err := cryptoc.Airdrops(
+		t.Context(),
+		cryptocurrency.WithAirdropsLimit(20),
+		cryptocurrency.WithAirdropsStatus(cryptocurrency.AirdropStatusUpcoming),
+		cryptocurrency.WithAirdropsCurrencies(currency.Symbol("DFL")),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, airdrops.Data, 1)
+
+	airdrop := airdrops.Data[0]
+	require.Equal(t, cryptocurrency.AirdropStatusUpcoming, airdrop.Status)
+	require.Equal(t, 10042, airdrop.Coin.ID)
+	require.Equal(t, time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC), airdrop.StartDate)
+}
+
+func TestAirdrop(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/cryptocurrency/airdrop", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t, "id=60e59e8d6bb8c4153ba4a4c7", querytest.RawQuery(t, ctx, preProcessFn))
+
+			rsp, ok := result.(*cryptocurrency.AirdropResponse)
+			require.True(t, ok)
+
+			rsp.Data = cryptocurrency.AirdropData{
+				ID:          "60e59e8d6bb8c4153ba4a4c7",
+				ProjectName: "DeFi Land",
+			}
+
+			return nil
+		})
+
+	airdrop, err := cryptoc.Airdrop(t.Context(), "60e59e8d6bb8c4153ba4a4c7")
+
+	require.NoError(t, err)
+	require.Equal(t, "DeFi Land", airdrop.Data.ProjectName)
+}
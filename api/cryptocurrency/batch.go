@@ -0,0 +1,98 @@
+package cryptocurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/batch"
+)
+
+const (
+	defaultBatchSize   = 100
+	defaultConcurrency = 1
+)
+
+// BatchError failed request for a batch of currencies.
+type BatchError struct {
+	Currencies []currency.Currency
+	Err        error
+}
+
+func (b *BatchError) Error() string {
+	return fmt.Sprintf("batch of %d currencies: %s", len(b.Currencies), b.Err.Error())
+}
+
+func (b *BatchError) Unwrap() error {
+	return b.Err
+}
+
+// PartialError returned instead of response when one or more batches failed.
+// Response contains merged data of succeeded batches, nil if all batches failed.
+type PartialError[R any] struct {
+	Response *R
+	Batches  []*BatchError
+}
+
+func (p *PartialError[R]) Error() string {
+	return fmt.Sprintf("%d batches failed: %s", len(p.Batches), errors.Join(p.Unwrap()...).Error())
+}
+
+func (p *PartialError[R]) Unwrap() []error {
+	errs := make([]error, 0, len(p.Batches))
+
+	for _, b := range p.Batches {
+		errs = append(errs, b)
+	}
+
+	return errs
+}
+
+type batchResponse[D any] struct {
+	Data   map[string]D
+	Status types.Status
+}
+
+// requestBatches requests currencies in batches and merges data of succeeded batches.
+// Credit count of all succeeded batches is summed, other status fields are taken from the first one.
+// Returned bool reports whether at least one batch succeeded, failed batches are returned with their errors.
+func requestBatches[D any](
+	ctx context.Context,
+	currencies []currency.Currency,
+	size int,
+	concurrency int,
+	request func(ctx context.Context, currencies []currency.Currency) (batchResponse[D], error),
+) (batchResponse[D], bool, []*BatchError) {
+	var (
+		merged = batchResponse[D]{
+			Data: make(map[string]D),
+		}
+		succeeded bool
+		failed    []*BatchError
+	)
+
+	for _, result := range batch.Run(ctx, currencies, size, concurrency, request) {
+		if result.Err != nil {
+			failed = append(failed, &BatchError{
+				Currencies: result.Items,
+				Err:        result.Err,
+			})
+
+			continue
+		}
+
+		if !succeeded {
+			merged.Status = result.Response.Status
+			merged.Status.CreditCount = 0
+			succeeded = true
+		}
+
+		merged.Status.CreditCount += result.Response.Status.CreditCount
+		maps.Copy(merged.Data, result.Response.Data)
+	}
+
+	return merged, succeeded, failed
+}
@@ -0,0 +1,129 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	categoriesEndpoint = "/v1/cryptocurrency/categories"
+)
+
+type CategoriesResponse struct {
+	Data   []CategoryInfo `json:"data"`
+	Status types.Status   `json:"status"`
+}
+
+// GetStatus returns response status.
+func (c *CategoriesResponse) GetStatus() types.Status {
+	return c.Status
+}
+
+type CategoryInfo struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	Title           string    `json:"title"`
+	Description     string    `json:"description"`
+	NumTokens       int       `json:"num_tokens"`
+	AvgPriceChange  float64   `json:"avg_price_change"`
+	MarketCap       float64   `json:"market_cap"`
+	MarketCapChange float64   `json:"market_cap_change"`
+	Volume          float64   `json:"volume"`
+	VolumeChange    float64   `json:"volume_change"`
+	LastUpdated     time.Time `json:"last_updated"`
+}
+
+type categoriesOptions struct {
+	Start      int
+	Limit      int
+	Currencies []currency.Currency
+}
+
+// CategoriesOption categories optional param.
+type CategoriesOption func(opts *categoriesOptions)
+
+// WithCategoriesStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithCategoriesStart(start int) CategoriesOption {
+	return func(opts *categoriesOptions) {
+		opts.Start = start
+	}
+}
+
+// WithCategoriesLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+func WithCategoriesLimit(limit int) CategoriesOption {
+	return func(opts *categoriesOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithCategoriesCurrencies filter categories by one or more cryptocurrencies.
+// Currencies should be specified either all by id, all by symbol or all by slug.
+func WithCategoriesCurrencies(currencies ...currency.Currency) CategoriesOption {
+	return func(opts *categoriesOptions) {
+		opts.Currencies = currencies
+	}
+}
+
+// Categories returns information about all coin categories available on CoinMarketCap.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CryptocurrencyCategories
+func (c *Cryptocurrency) Categories(
+	ctx context.Context,
+	withOpts ...CategoriesOption,
+) (*CategoriesResponse, error) {
+	var (
+		options = categoriesOptions{
+			Start: 1,
+		}
+		response CategoriesResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		categoriesEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeCategoriesQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeCategoriesQuery(options categoriesOptions) string {
+	query := make(url.Values)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	urlquery.AddCurrencies(query, options.Currencies)
+
+	return query.Encode()
+}
@@ -0,0 +1,154 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	categoriesResponseBody = `
+{
+	"data": [
+		{
+			"id": "605e2ce9d41eae1066535f7c",
+			"name": "A16Z Portfolio",
+			"title": "A16Z Portfolio",
+			"description": "A16Z Portfolio",
+			"num_tokens": 12,
+			"avg_price_change": 1.25,
+			"market_cap": 69454867383.5,
+			"market_cap_change": 0.84,
+			"volume": 2495294889.25,
+			"volume_change": -12.3,
+			"last_updated": "2025-06-28T16:15:00.000Z"
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+
+	categoryResponseBody = `
+{
+	"data": {
+		"id": "605e2ce9d41eae1066535f7c",
+		"name": "A16Z Portfolio",
+		"title": "A16Z Portfolio",
+		"description": "A16Z Portfolio",
+		"num_tokens": 12,
+		"market_cap": 69454867383.5,
+		"last_updated": "2025-06-28T16:15:00.000Z",
+		"coins": [
+			{
+				"id": 1027,
+				"name": "Ethereum",
+				"symbol": "ETH",
+				"slug": "ethereum",
+				"cmc_rank": 2,
+				"quote": {
+					"EUR": {
+						"price": 2101.35
+					}
+				}
+			}
+		]
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestCategories(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/cryptocurrency/categories", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t, "limit=5&slug=bitcoin%2Cethereum&start=6", querytest.RawQuery(t, ctx, preProcessFn))
+
+			return json.Unmarshal([]byte(categoriesResponseBody), result)
+		})
+
+	categories, err := cryptoc.Categories(
+		t.Context(),
+		cryptocurrency.WithCategoriesStart(6),
+		cryptocurrency.WithCategoriesLimit(5),
+		cryptocurrency.WithCategoriesCurrencies(currency.Slug("bitcoin"), currency.Slug("ethereum")),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, categories.Data, 1)
+	require.Equal(t, "605e2ce9d41eae1066535f7c", categories.Data[0].ID)
+	require.Equal(t, 12, categories.Data[0].NumTokens)
+}
+
+func TestCategory(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/cryptocurrency/category", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"convert=EUR&id=605e2ce9d41eae1066535f7c&limit=10&start=1",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(categoryResponseBody), result)
+		})
+
+	category, err := cryptoc.Category(
+		t.Context(),
+		"605e2ce9d41eae1066535f7c",
+		cryptocurrency.WithCategoryLimit(10),
+		cryptocurrency.WithCategoryConvert(currency.Symbol("EUR")),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, category.Data.Coins, 1)
+	require.Equal(t, "ethereum", category.Data.Coins[0].Slug)
+	require.InDelta(t, 2101.35, category.Data.Coins[0].Quotes["EUR"].Price, 0)
+}
@@ -0,0 +1,134 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	categoryEndpoint = "/v1/cryptocurrency/category"
+)
+
+type CategoryResponse struct {
+	Data   CategoryData `json:"data"`
+	Status types.Status `json:"status"`
+}
+
+// GetStatus returns response status.
+func (c *CategoryResponse) GetStatus() types.Status {
+	return c.Status
+}
+
+type CategoryData struct {
+	ID              string        `json:"id"`
+	Name            string        `json:"name"`
+	Title           string        `json:"title"`
+	Description     string        `json:"description"`
+	NumTokens       int           `json:"num_tokens"`
+	AvgPriceChange  float64       `json:"avg_price_change"`
+	MarketCap       float64       `json:"market_cap"`
+	MarketCapChange float64       `json:"market_cap_change"`
+	Volume          float64       `json:"volume"`
+	VolumeChange    float64       `json:"volume_change"`
+	LastUpdated     time.Time     `json:"last_updated"`
+	Coins           []ListingData `json:"coins"`
+}
+
+type categoryOptions struct {
+	Start   int
+	Limit   int
+	Convert []currency.Currency
+}
+
+// CategoryOption category optional param.
+type CategoryOption func(opts *categoryOptions)
+
+// WithCategoryStart offset the start (1-based index) of the paginated list of coins to return.
+// Default 1.
+func WithCategoryStart(start int) CategoryOption {
+	return func(opts *categoryOptions) {
+		opts.Start = start
+	}
+}
+
+// WithCategoryLimit specify the number of coins to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+func WithCategoryLimit(limit int) CategoryOption {
+	return func(opts *categoryOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithCategoryConvert calculate market quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithCategoryConvert(currencies ...currency.Currency) CategoryOption {
+	return func(opts *categoryOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// Category returns information about a single coin category available on CoinMarketCap
+// including the list of coins in the category with their market quotes.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CryptocurrencyCategory
+func (c *Cryptocurrency) Category(
+	ctx context.Context,
+	categoryID string,
+	withOpts ...CategoryOption,
+) (*CategoryResponse, error) {
+	var (
+		options = categoryOptions{
+			Start: 1,
+		}
+		response CategoryResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		categoryEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeCategoryQuery(categoryID, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeCategoryQuery(categoryID string, options categoryOptions) string {
+	query := make(url.Values)
+	query.Add("id", categoryID)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	return query.Encode()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Mikhalevich/coinmarketcap"
 	"github.com/Mikhalevich/coinmarketcap/api/types"
 	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
 )
 
 const (
@@ -22,6 +23,11 @@ type InfoResponse struct {
 	Status types.Status        `json:"status"`
 }
 
+// GetStatus returns response status.
+func (i *InfoResponse) GetStatus() types.Status {
+	return i.Status
+}
+
 type InfoData struct {
 	ID                            int              `json:"id"`
 	Name                          string           `json:"name"`
@@ -58,6 +64,8 @@ type infoOptions struct {
 	Address     string
 	Aux         []string
 	SkipInvalid bool
+	BatchSize   int
+	Concurrency int
 }
 
 // InfoOption info optional param.
@@ -89,24 +97,89 @@ func WithInfoAux(fields ...string) InfoOption {
 	}
 }
 
+// WithInfoBatchSize maximum number of currencies requested at once.
+// Larger currency lists are split into batches and merged into a single response.
+// Non-positive size disables splitting.
+// Default 100.
+func WithInfoBatchSize(size int) InfoOption {
+	return func(opts *infoOptions) {
+		opts.BatchSize = size
+	}
+}
+
+// WithInfoConcurrency maximum number of batches requested in parallel.
+// Default 1.
+func WithInfoConcurrency(concurrency int) InfoOption {
+	return func(opts *infoOptions) {
+		opts.Concurrency = concurrency
+	}
+}
+
 // Info returns all static metadata available for one or more cryptocurrencies.
+// If currencies exceed batch size they are requested in batches, in case some of the batches failed
+// *PartialError containing response with data of succeeded batches is returned.
 // https://coinmarketcap.com/api/documentation/v1/#operation/getV2CryptocurrencyInfo
 func (c *Cryptocurrency) Info(
 	ctx context.Context,
 	currencies []currency.Currency,
 	withOpts ...InfoOption,
 ) (*InfoResponse, error) {
-	var (
-		options = infoOptions{
-			SkipInvalid: true,
-		}
-		response InfoResponse
-	)
+	options := infoOptions{
+		SkipInvalid: true,
+		BatchSize:   defaultBatchSize,
+		Concurrency: defaultConcurrency,
+	}
 
 	for _, option := range withOpts {
 		option(&options)
 	}
 
+	if options.Address != "" || options.BatchSize <= 0 || len(currencies) <= options.BatchSize {
+		return c.info(ctx, currencies, options)
+	}
+
+	merged, succeeded, failed := requestBatches(
+		ctx,
+		currencies,
+		options.BatchSize,
+		options.Concurrency,
+		func(ctx context.Context, chunk []currency.Currency) (batchResponse[InfoData], error) {
+			rsp, err := c.info(ctx, chunk, options)
+			if err != nil {
+				return batchResponse[InfoData]{}, err
+			}
+
+			return batchResponse[InfoData]{Data: rsp.Data, Status: rsp.Status}, nil
+		},
+	)
+
+	response := &InfoResponse{
+		Data:   merged.Data,
+		Status: merged.Status,
+	}
+
+	if len(failed) > 0 {
+		partialErr := &PartialError[InfoResponse]{
+			Batches: failed,
+		}
+
+		if succeeded {
+			partialErr.Response = response
+		}
+
+		return nil, partialErr
+	}
+
+	return response, nil
+}
+
+func (c *Cryptocurrency) info(
+	ctx context.Context,
+	currencies []currency.Currency,
+	options infoOptions,
+) (*InfoResponse, error) {
+	var response InfoResponse
+
 	if err := c.executor.Get(
 		ctx,
 		infoEndpoint,
@@ -136,11 +209,11 @@ func makeInfoQuery(
 	if options.Address != "" {
 		query.Add("address", options.Address)
 	} else {
-		query.Add(makeCurrencyQueryKey(currencies), makeCommaSeparatedValues(convertCurrenciesToQueryKey(currencies)))
+		urlquery.AddCurrencies(query, currencies)
 	}
 
 	if len(options.Aux) > 0 {
-		query.Add("aux", makeCommaSeparatedValues(options.Aux))
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
 	}
 
 	query.Add("skip_invalid", strconv.FormatBool(options.SkipInvalid))
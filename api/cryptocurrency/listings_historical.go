@@ -0,0 +1,192 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	listingsHistoricalEndpoint = "/v1/cryptocurrency/listings/historical"
+)
+
+type ListingsHistoricalResponse struct {
+	Data   []ListingData `json:"data"`
+	Status types.Status  `json:"status"`
+}
+
+// GetStatus returns response status.
+func (l *ListingsHistoricalResponse) GetStatus() types.Status {
+	return l.Status
+}
+
+type ListingsHistoricalSortField string
+
+func (l ListingsHistoricalSortField) String() string {
+	return string(l)
+}
+
+const (
+	ListingsHistoricalSortCMCRank           ListingsHistoricalSortField = "cmc_rank"
+	ListingsHistoricalSortName              ListingsHistoricalSortField = "name"
+	ListingsHistoricalSortSymbol            ListingsHistoricalSortField = "symbol"
+	ListingsHistoricalSortMarketCap         ListingsHistoricalSortField = "market_cap"
+	ListingsHistoricalSortPrice             ListingsHistoricalSortField = "price"
+	ListingsHistoricalSortCirculatingSupply ListingsHistoricalSortField = "circulating_supply"
+	ListingsHistoricalSortTotalSupply       ListingsHistoricalSortField = "total_supply"
+	ListingsHistoricalSortMaxSupply         ListingsHistoricalSortField = "max_supply"
+	ListingsHistoricalSortNumMarketPairs    ListingsHistoricalSortField = "num_market_pairs"
+	ListingsHistoricalSortVolume24h         ListingsHistoricalSortField = "volume_24h"
+	ListingsHistoricalSortPercentChange1h   ListingsHistoricalSortField = "percent_change_1h"
+	ListingsHistoricalSortPercentChange24h  ListingsHistoricalSortField = "percent_change_24h"
+	ListingsHistoricalSortPercentChange7d   ListingsHistoricalSortField = "percent_change_7d"
+)
+
+type listingsHistoricalOptions struct {
+	Start              int
+	Limit              int
+	Convert            []currency.Currency
+	Sort               ListingsHistoricalSortField
+	SortDir            types.SortDir
+	CryptocurrencyType ListingType
+	Aux                []string
+}
+
+// ListingsHistoricalOption listings historical optional param.
+type ListingsHistoricalOption func(opts *listingsHistoricalOptions)
+
+// WithLHStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithLHStart(start int) ListingsHistoricalOption {
+	return func(opts *listingsHistoricalOptions) {
+		opts.Start = start
+	}
+}
+
+// WithLHLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+// Default 100.
+func WithLHLimit(limit int) ListingsHistoricalOption {
+	return func(opts *listingsHistoricalOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithLHConvert calculate market quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithLHConvert(currencies ...currency.Currency) ListingsHistoricalOption {
+	return func(opts *listingsHistoricalOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// WithLHSort what field to sort the list of cryptocurrencies by.
+// Default "cmc_rank".
+func WithLHSort(field ListingsHistoricalSortField) ListingsHistoricalOption {
+	return func(opts *listingsHistoricalOptions) {
+		opts.Sort = field
+	}
+}
+
+// WithLHSortDir the direction in which to order cryptocurrencies against the specified sort.
+func WithLHSortDir(dir types.SortDir) ListingsHistoricalOption {
+	return func(opts *listingsHistoricalOptions) {
+		opts.SortDir = dir
+	}
+}
+
+// WithLHCryptocurrencyType the type of cryptocurrency to include.
+// Default "all".
+func WithLHCryptocurrencyType(listingType ListingType) ListingsHistoricalOption {
+	return func(opts *listingsHistoricalOptions) {
+		opts.CryptocurrencyType = listingType
+	}
+}
+
+// WithLHAux specify a list of supplemental data fields to return.
+// By default "platform,tags,date_added,circulating_supply,total_supply,max_supply,cmc_rank,num_market_pairs".
+func WithLHAux(fields ...string) ListingsHistoricalOption {
+	return func(opts *listingsHistoricalOptions) {
+		opts.Aux = fields
+	}
+}
+
+// ListingsHistorical returns a ranked and sorted list of all cryptocurrencies for a historical UTC date.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CryptocurrencyListingsHistorical
+func (c *Cryptocurrency) ListingsHistorical(
+	ctx context.Context,
+	date time.Time,
+	withOpts ...ListingsHistoricalOption,
+) (*ListingsHistoricalResponse, error) {
+	var (
+		options = listingsHistoricalOptions{
+			Start: 1,
+		}
+		response ListingsHistoricalResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		listingsHistoricalEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeListingsHistoricalQuery(date, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeListingsHistoricalQuery(date time.Time, options listingsHistoricalOptions) string {
+	query := make(url.Values)
+	query.Add("date", date.UTC().Format(time.RFC3339))
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	if options.Sort != "" {
+		query.Add("sort", options.Sort.String())
+	}
+
+	if options.SortDir != "" {
+		query.Add("sort_dir", options.SortDir.String())
+	}
+
+	if options.CryptocurrencyType != "" {
+		query.Add("cryptocurrency_type", options.CryptocurrencyType.String())
+	}
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	return query.Encode()
+}
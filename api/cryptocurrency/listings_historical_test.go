@@ -0,0 +1,91 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	listingsHistoricalResponseBody = `
+{
+	"data": [
+		{
+			"id": 1,
+			"name": "Bitcoin",
+			"symbol": "BTC",
+			"slug": "bitcoin",
+			"cmc_rank": 1,
+			"num_market_pairs": 11000,
+			"quote": {
+				"2781": {
+					"price": 107094.51,
+					"market_cap": 2133525441498.32
+				}
+			}
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestListingsHistorical(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/cryptocurrency/listings/historical", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"aux=cmc_rank%2Cnum_market_pairs&convert_id=2781&cryptocurrency_type=coins"+
+					"&date=2025-06-27T00%3A00%3A00Z&limit=50&sort=cmc_rank&sort_dir=asc&start=1",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(listingsHistoricalResponseBody), result)
+		})
+
+	listings, err := cryptoc.ListingsHistorical(
+		t.Context(),
+		time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC),
+		cryptocurrency.WithLHLimit(50),
+		cryptocurrency.WithLHConvert(currency.ID(2781)),
+		cryptocurrency.WithLHSort(cryptocurrency.ListingsHistoricalSortCMCRank),
+		cryptocurrency.WithLHSortDir(types.SortDirAsc),
+		cryptocurrency.WithLHCryptocurrencyType(cryptocurrency.ListingTypeCoins),
+		cryptocurrency.WithLHAux("cmc_rank", "num_market_pairs"),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, listings.Data, 1)
+	require.Equal(t, 1, listings.Data[0].CMCRank)
+	require.InDelta(t, 107094.51, listings.Data[0].Quotes["2781"].Price, 0)
+}
@@ -0,0 +1,343 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	listingsLatestEndpoint = "/v1/cryptocurrency/listings/latest"
+)
+
+type ListingsLatestResponse struct {
+	Data   []ListingData `json:"data"`
+	Status types.Status  `json:"status"`
+}
+
+// GetStatus returns response status.
+func (l *ListingsLatestResponse) GetStatus() types.Status {
+	return l.Status
+}
+
+type ListingData struct {
+	ID                            int              `json:"id"`
+	Name                          string           `json:"name"`
+	Symbol                        string           `json:"symbol"`
+	Slug                          string           `json:"slug"`
+	CMCRank                       int              `json:"cmc_rank"`
+	NumMarketPairs                int              `json:"num_market_pairs"`
+	CirculatingSupply             float64          `json:"circulating_supply"`
+	TotalSupply                   float64          `json:"total_supply"`
+	MarketCapByTotalSupply        float64          `json:"market_cap_by_total_supply"`
+	MaxSupply                     float64          `json:"max_supply"`
+	InfiniteSupply                bool             `json:"infinite_supply"`
+	DateAdded                     time.Time        `json:"date_added"`
+	Tags                          []string         `json:"tags"`
+	Platform                      types.PlatformV1 `json:"platform"`
+	LastUpdated                   time.Time        `json:"last_updated"`
+	SelfReportedCirculatingSupply float64          `json:"self_reported_circulating_supply"`
+	SelfReportedMarketCap         float64          `json:"self_reported_market_cap"`
+	Quotes                        map[string]Quote `json:"quote"`
+}
+
+type ListingType string
+
+func (l ListingType) String() string {
+	return string(l)
+}
+
+const (
+	ListingTypeAll    ListingType = "all"
+	ListingTypeCoins  ListingType = "coins"
+	ListingTypeTokens ListingType = "tokens"
+)
+
+type ListingTag string
+
+func (l ListingTag) String() string {
+	return string(l)
+}
+
+const (
+	ListingTagAll         ListingTag = "all"
+	ListingTagDefi        ListingTag = "defi"
+	ListingTagFilesharing ListingTag = "filesharing"
+)
+
+type ListingSortField string
+
+func (l ListingSortField) String() string {
+	return string(l)
+}
+
+const (
+	ListingSortMarketCap                    ListingSortField = "market_cap"
+	ListingSortMarketCapStrict              ListingSortField = "market_cap_strict"
+	ListingSortName                         ListingSortField = "name"
+	ListingSortSymbol                       ListingSortField = "symbol"
+	ListingSortDateAdded                    ListingSortField = "date_added"
+	ListingSortPrice                        ListingSortField = "price"
+	ListingSortCirculatingSupply            ListingSortField = "circulating_supply"
+	ListingSortTotalSupply                  ListingSortField = "total_supply"
+	ListingSortMaxSupply                    ListingSortField = "max_supply"
+	ListingSortNumMarketPairs               ListingSortField = "num_market_pairs"
+	ListingSortMarketCapByTotalSupplyStrict ListingSortField = "market_cap_by_total_supply_strict"
+	ListingSortVolume24h                    ListingSortField = "volume_24h"
+	ListingSortVolume7d                     ListingSortField = "volume_7d"
+	ListingSortVolume30d                    ListingSortField = "volume_30d"
+	ListingSortPercentChange1h              ListingSortField = "percent_change_1h"
+	ListingSortPercentChange24h             ListingSortField = "percent_change_24h"
+	ListingSortPercentChange7d              ListingSortField = "percent_change_7d"
+)
+
+type listingsLatestOptions struct {
+	Start                int
+	Limit                int
+	PriceMin             *float64
+	PriceMax             *float64
+	MarketCapMin         *float64
+	MarketCapMax         *float64
+	Volume24hMin         *float64
+	Volume24hMax         *float64
+	CirculatingSupplyMin *float64
+	CirculatingSupplyMax *float64
+	PercentChange24hMin  *float64
+	PercentChange24hMax  *float64
+	Convert              []currency.Currency
+	Sort                 ListingSortField
+	SortDir              types.SortDir
+	CryptocurrencyType   ListingType
+	Tag                  ListingTag
+	Aux                  []string
+}
+
+// ListingsLatestOption listings latest optional param.
+type ListingsLatestOption func(opts *listingsLatestOptions)
+
+// WithLLStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithLLStart(start int) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Start = start
+	}
+}
+
+// WithLLLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+// Default 100.
+func WithLLLimit(limit int) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithLLPriceMin threshold of minimum USD price to filter results by.
+func WithLLPriceMin(price float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.PriceMin = &price
+	}
+}
+
+// WithLLPriceMax threshold of maximum USD price to filter results by.
+func WithLLPriceMax(price float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.PriceMax = &price
+	}
+}
+
+// WithLLMarketCapMin threshold of minimum market cap to filter results by.
+func WithLLMarketCapMin(marketCap float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.MarketCapMin = &marketCap
+	}
+}
+
+// WithLLMarketCapMax threshold of maximum market cap to filter results by.
+func WithLLMarketCapMax(marketCap float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.MarketCapMax = &marketCap
+	}
+}
+
+// WithLLVolume24hMin threshold of minimum 24 hour USD volume to filter results by.
+func WithLLVolume24hMin(volume float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Volume24hMin = &volume
+	}
+}
+
+// WithLLVolume24hMax threshold of maximum 24 hour USD volume to filter results by.
+func WithLLVolume24hMax(volume float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Volume24hMax = &volume
+	}
+}
+
+// WithLLCirculatingSupplyMin threshold of minimum circulating supply to filter results by.
+func WithLLCirculatingSupplyMin(supply float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.CirculatingSupplyMin = &supply
+	}
+}
+
+// WithLLCirculatingSupplyMax threshold of maximum circulating supply to filter results by.
+func WithLLCirculatingSupplyMax(supply float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.CirculatingSupplyMax = &supply
+	}
+}
+
+// WithLLPercentChange24hMin threshold of minimum 24 hour percent change to filter results by.
+func WithLLPercentChange24hMin(percent float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.PercentChange24hMin = &percent
+	}
+}
+
+// WithLLPercentChange24hMax threshold of maximum 24 hour percent change to filter results by.
+func WithLLPercentChange24hMax(percent float64) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.PercentChange24hMax = &percent
+	}
+}
+
+// WithLLConvert calculate market quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithLLConvert(currencies ...currency.Currency) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// WithLLSort what field to sort the list of cryptocurrencies by.
+// Default "market_cap".
+func WithLLSort(field ListingSortField) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Sort = field
+	}
+}
+
+// WithLLSortDir the direction in which to order cryptocurrencies against the specified sort.
+func WithLLSortDir(dir types.SortDir) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.SortDir = dir
+	}
+}
+
+// WithLLCryptocurrencyType the type of cryptocurrency to include.
+// Default "all".
+func WithLLCryptocurrencyType(listingType ListingType) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.CryptocurrencyType = listingType
+	}
+}
+
+// WithLLTag the tag of cryptocurrency to include.
+// Default "all".
+func WithLLTag(tag ListingTag) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Tag = tag
+	}
+}
+
+// WithLLAux specify a list of supplemental data fields to return.
+// By default "num_market_pairs,cmc_rank,date_added,tags,platform,max_supply,circulating_supply,total_supply".
+func WithLLAux(fields ...string) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Aux = fields
+	}
+}
+
+// ListingsLatest returns a paginated list of all active cryptocurrencies with latest market data.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CryptocurrencyListingsLatest
+func (c *Cryptocurrency) ListingsLatest(
+	ctx context.Context,
+	withOpts ...ListingsLatestOption,
+) (*ListingsLatestResponse, error) {
+	var (
+		options = listingsLatestOptions{
+			Start: 1,
+		}
+		response ListingsLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		listingsLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeListingsLatestQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeListingsLatestQuery(options listingsLatestOptions) string {
+	query := make(url.Values)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	urlquery.AddFloat(query, "price_min", options.PriceMin)
+	urlquery.AddFloat(query, "price_max", options.PriceMax)
+	urlquery.AddFloat(query, "market_cap_min", options.MarketCapMin)
+	urlquery.AddFloat(query, "market_cap_max", options.MarketCapMax)
+	urlquery.AddFloat(query, "volume_24h_min", options.Volume24hMin)
+	urlquery.AddFloat(query, "volume_24h_max", options.Volume24hMax)
+	urlquery.AddFloat(query, "circulating_supply_min", options.CirculatingSupplyMin)
+	urlquery.AddFloat(query, "circulating_supply_max", options.CirculatingSupplyMax)
+	urlquery.AddFloat(query, "percent_change_24h_min", options.PercentChange24hMin)
+	urlquery.AddFloat(query, "percent_change_24h_max", options.PercentChange24hMax)
+
+	urlquery.AddConvert(query, options.Convert)
+
+	if options.Sort != "" {
+		query.Add("sort", options.Sort.String())
+	}
+
+	if options.SortDir != "" {
+		query.Add("sort_dir", options.SortDir.String())
+	}
+
+	if options.CryptocurrencyType != "" {
+		query.Add("cryptocurrency_type", options.CryptocurrencyType.String())
+	}
+
+	if options.Tag != "" {
+		query.Add("tag", options.Tag.String())
+	}
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	return query.Encode()
+}
@@ -0,0 +1,99 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+func TestListingsLatestQuery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/cryptocurrency/listings/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			require.NoError(t, preProcessFn(req))
+
+			require.Equal(t,
+				"convert_id=2781%2C2790&cryptocurrency_type=tokens&limit=10&percent_change_24h_min=-5.5"+
+					"&price_min=0&sort=price&sort_dir=asc&start=1&tag=defi",
+				req.URL.RawQuery,
+			)
+
+			rsp, ok := result.(*cryptocurrency.ListingsLatestResponse)
+			require.True(t, ok)
+
+			rsp.Data = []cryptocurrency.ListingData{{ID: 1, Symbol: "BTC"}}
+
+			return nil
+		})
+
+	listings, err := cryptoc.ListingsLatest(
+		t.Context(),
+		cryptocurrency.WithLLLimit(10),
+		cryptocurrency.WithLLPriceMin(0),
+		cryptocurrency.WithLLPercentChange24hMin(-5.5),
+		cryptocurrency.WithLLConvert(currency.ID(2781), currency.ID(2790)),
+		cryptocurrency.WithLLSort(cryptocurrency.ListingSortPrice),
+		cryptocurrency.WithLLSortDir(types.SortDirAsc),
+		cryptocurrency.WithLLCryptocurrencyType(cryptocurrency.ListingTypeTokens),
+		cryptocurrency.WithLLTag(cryptocurrency.ListingTagDefi),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, listings.Data, 1)
+	require.Equal(t, "BTC", listings.Data[0].Symbol)
+}
+
+func TestListingsLatestStatusError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/cryptocurrency/listings/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			rsp, ok := result.(*cryptocurrency.ListingsLatestResponse)
+			require.True(t, ok)
+
+			rsp.Status.ErrorCode = 1002
+			rsp.Status.ErrorMessage = "API key missing."
+
+			return nil
+		})
+
+	listings, err := cryptoc.ListingsLatest(t.Context())
+
+	require.Nil(t, listings)
+	require.Equal(t, coinmarketcap.NewError(1002, "API key missing."), err)
+}
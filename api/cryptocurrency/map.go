@@ -10,6 +10,7 @@ import (
 
 	"github.com/Mikhalevich/coinmarketcap"
 	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
 )
 
 const (
@@ -21,6 +22,11 @@ type MapResponse struct {
 	Status types.Status `json:"status"`
 }
 
+// GetStatus returns response status.
+func (m *MapResponse) GetStatus() types.Status {
+	return m.Status
+}
+
 type MapStatus string
 
 func (m MapStatus) String() string {
@@ -175,11 +181,11 @@ func makeMapQuery(options mapOptions) string {
 	}
 
 	if len(options.Symbol) > 0 {
-		query.Add("symbol", makeCommaSeparatedValues(options.Symbol))
+		query.Add("symbol", urlquery.CommaSeparated(options.Symbol))
 	}
 
 	if len(options.Aux) > 0 {
-		query.Add("aux", makeCommaSeparatedValues(options.Aux))
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
 	}
 
 	return query.Encode()
@@ -0,0 +1,103 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+)
+
+func TestMapAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+		queries      []string
+		ids          []int
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/cryptocurrency/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			require.NoError(t, preProcessFn(req))
+
+			queries = append(queries, req.URL.RawQuery)
+
+			rsp, ok := result.(*cryptocurrency.MapResponse)
+			require.True(t, ok)
+
+			switch req.URL.Query().Get("start") {
+			case "1":
+				rsp.Data = []cryptocurrency.MapData{{ID: 1}, {ID: 2}}
+			case "3":
+				rsp.Data = []cryptocurrency.MapData{{ID: 3}}
+			}
+
+			return nil
+		}).
+		Times(2)
+
+	for data, err := range cryptoc.MapAll(t.Context(), cryptocurrency.WithMapLimit(2)) {
+		require.NoError(t, err)
+
+		ids = append(ids, data.ID)
+	}
+
+	require.Equal(t, []int{1, 2, 3}, ids)
+	require.Equal(t, []string{
+		"limit=2&listing_status=active&sort=id&start=1",
+		"limit=2&listing_status=active&sort=id&start=3",
+	}, queries)
+}
+
+func TestMapAllStatusError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+		errs         []error
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/cryptocurrency/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			rsp, ok := result.(*cryptocurrency.MapResponse)
+			require.True(t, ok)
+
+			rsp.Status.ErrorCode = 1008
+			rsp.Status.ErrorMessage = "You've exceeded your API Key's HTTP request rate limit."
+
+			return nil
+		})
+
+	for _, err := range cryptoc.MapAll(t.Context()) {
+		errs = append(errs, err)
+	}
+
+	require.Len(t, errs, 1)
+
+	var cmcErr *coinmarketcap.Error
+	require.ErrorAs(t, errs[0], &cmcErr)
+	require.Equal(t, 1008, cmcErr.Code)
+}
@@ -0,0 +1,119 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	marketPairsLatestResponseBody = `
+{
+	"data": {
+		"id": 1,
+		"name": "Bitcoin",
+		"symbol": "BTC",
+		"num_market_pairs": 12000,
+		"market_pairs": [
+			{
+				"exchange": {
+					"id": 270,
+					"name": "Binance",
+					"slug": "binance"
+				},
+				"market_id": 9933,
+				"market_pair": "BTC/USDT",
+				"category": "spot",
+				"fee_type": "percentage",
+				"market_pair_base": {
+					"currency_id": 1,
+					"currency_symbol": "BTC",
+					"exchange_symbol": "BTC",
+					"currency_type": "cryptocurrency"
+				},
+				"market_pair_quote": {
+					"currency_id": 825,
+					"currency_symbol": "USDT",
+					"exchange_symbol": "USDT",
+					"currency_type": "cryptocurrency"
+				},
+				"quote": {
+					"USD": {
+						"price": 107296.79,
+						"volume_24h": 1200000000.5,
+						"depth_negative_two": 25000000.1,
+						"depth_positive_two": 27000000.2,
+						"last_updated": "2025-06-28T16:18:00.000Z"
+					}
+				}
+			}
+		]
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestMarketPairsLatest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v2/cryptocurrency/market-pairs/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"category=spot&convert_id=2781&fee_type=percentage&id=1&limit=10&matched_id=825"+
+					"&sort=cmc_rank&sort_dir=asc&start=11",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(marketPairsLatestResponseBody), result)
+		})
+
+	pairs, err := cryptoc.MarketPairsLatest(
+		t.Context(),
+		currency.ID(1),
+		cryptocurrency.WithMPStart(11),
+		cryptocurrency.WithMPLimit(10),
+		cryptocurrency.WithMPSort(cryptocurrency.MarketPairSortCMCRank),
+		cryptocurrency.WithMPSortDir(types.SortDirAsc),
+		cryptocurrency.WithMPMatched(currency.ID(825)),
+		cryptocurrency.WithMPCategory(cryptocurrency.MarketPairCategorySpot),
+		cryptocurrency.WithMPFeeType(cryptocurrency.MarketPairFeeTypePercentage),
+		cryptocurrency.WithMPConvert(currency.ID(2781)),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, pairs.Data.MarketPairs, 1)
+
+	pair := pairs.Data.MarketPairs[0]
+	require.Equal(t, "binance", pair.Exchange.Slug)
+	require.Equal(t, 825, pair.Quote.CurrencyID)
+	require.InDelta(t, 25000000.1, pair.Quotes["USD"].DepthNegativeTwo, 0)
+}
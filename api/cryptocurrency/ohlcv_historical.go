@@ -0,0 +1,194 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	ohlcvHistoricalEndpoint = "/v2/cryptocurrency/ohlcv/historical"
+)
+
+type OHLCVHistoricalResponse struct {
+	Data   map[string]OHLCVHistoricalData `json:"data"`
+	Status types.Status                   `json:"status"`
+}
+
+// GetStatus returns response status.
+func (o *OHLCVHistoricalResponse) GetStatus() types.Status {
+	return o.Status
+}
+
+type OHLCVHistoricalData struct {
+	ID      int           `json:"id"`
+	Name    string        `json:"name"`
+	Symbol  string        `json:"symbol"`
+	Candles []OHLCVCandle `json:"quotes"`
+}
+
+type OHLCVCandle struct {
+	TimeOpen  time.Time        `json:"time_open"`
+	TimeClose time.Time        `json:"time_close"`
+	TimeHigh  time.Time        `json:"time_high"`
+	TimeLow   time.Time        `json:"time_low"`
+	Quotes    map[string]OHLCV `json:"quote"`
+}
+
+type OHLCVTimePeriod string
+
+func (o OHLCVTimePeriod) String() string {
+	return string(o)
+}
+
+const (
+	OHLCVTimePeriodDaily  OHLCVTimePeriod = "daily"
+	OHLCVTimePeriodHourly OHLCVTimePeriod = "hourly"
+)
+
+type ohlcvHistoricalOptions struct {
+	TimePeriod  OHLCVTimePeriod
+	TimeStart   time.Time
+	TimeEnd     time.Time
+	Count       int
+	Interval    types.Interval
+	Convert     []currency.Currency
+	SkipInvalid bool
+}
+
+// OHLCVHistoricalOption ohlcv historical optional param.
+type OHLCVHistoricalOption func(opts *ohlcvHistoricalOptions)
+
+// WithOHTimePeriod time period to return OHLCV data for.
+// Default "daily".
+func WithOHTimePeriod(period OHLCVTimePeriod) OHLCVHistoricalOption {
+	return func(opts *ohlcvHistoricalOptions) {
+		opts.TimePeriod = period
+	}
+}
+
+// WithOHTimeStart timestamp to start returning OHLCV time periods for.
+// Only the date portion of the timestamp is used for daily OHLCV.
+func WithOHTimeStart(start time.Time) OHLCVHistoricalOption {
+	return func(opts *ohlcvHistoricalOptions) {
+		opts.TimeStart = start
+	}
+}
+
+// WithOHTimeEnd timestamp to stop returning OHLCV time periods for (inclusive).
+// Optional, if not passed we'll default to the current time.
+func WithOHTimeEnd(end time.Time) OHLCVHistoricalOption {
+	return func(opts *ohlcvHistoricalOptions) {
+		opts.TimeEnd = end
+	}
+}
+
+// WithOHCount the number of interval periods to return results for.
+// Default 10.
+func WithOHCount(count int) OHLCVHistoricalOption {
+	return func(opts *ohlcvHistoricalOptions) {
+		opts.Count = count
+	}
+}
+
+// WithOHInterval interval of time to return data points for.
+// Default "daily".
+func WithOHInterval(interval types.Interval) OHLCVHistoricalOption {
+	return func(opts *ohlcvHistoricalOptions) {
+		opts.Interval = interval
+	}
+}
+
+// WithOHConvert calculate OHLCV quotes in up to 3 other currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithOHConvert(currencies ...currency.Currency) OHLCVHistoricalOption {
+	return func(opts *ohlcvHistoricalOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// WithOHSkipInvalid specify request validation rules.
+// If set to true, invalid lookups will be skipped allowing valid cryptocurrencies to still be returned.
+// By default true.
+func WithOHSkipInvalid(skip bool) OHLCVHistoricalOption {
+	return func(opts *ohlcvHistoricalOptions) {
+		opts.SkipInvalid = skip
+	}
+}
+
+// OHLCVHistorical returns historical OHLCV (Open, High, Low, Close, Volume) data
+// along with market cap for any cryptocurrency using time interval parameters.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV2CryptocurrencyOhlcvHistorical
+func (c *Cryptocurrency) OHLCVHistorical(
+	ctx context.Context,
+	currencies []currency.Currency,
+	withOpts ...OHLCVHistoricalOption,
+) (*OHLCVHistoricalResponse, error) {
+	var (
+		options = ohlcvHistoricalOptions{
+			SkipInvalid: true,
+		}
+		response OHLCVHistoricalResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		ohlcvHistoricalEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeOHLCVHistoricalQuery(currencies, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeOHLCVHistoricalQuery(
+	currencies []currency.Currency,
+	options ohlcvHistoricalOptions,
+) string {
+	query := make(url.Values)
+	urlquery.AddCurrencies(query, currencies)
+
+	if options.TimePeriod != "" {
+		query.Add("time_period", options.TimePeriod.String())
+	}
+
+	urlquery.AddTime(query, "time_start", options.TimeStart)
+	urlquery.AddTime(query, "time_end", options.TimeEnd)
+
+	if options.Count > 0 {
+		query.Add("count", strconv.Itoa(options.Count))
+	}
+
+	if options.Interval != "" {
+		query.Add("interval", options.Interval.String())
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	query.Add("skip_invalid", strconv.FormatBool(options.SkipInvalid))
+
+	return query.Encode()
+}
@@ -0,0 +1,104 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+const (
+	ohlcvHistoricalResponseBody = `
+{
+	"data": {
+		"1": {
+			"id": 1,
+			"name": "Bitcoin",
+			"symbol": "BTC",
+			"quotes": [
+				{
+					"time_open": "2025-06-27T00:00:00.000Z",
+					"time_close": "2025-06-27T23:59:59.999Z",
+					"time_high": "2025-06-27T14:09:00.000Z",
+					"time_low": "2025-06-27T03:27:00.000Z",
+					"quote": {
+						"USD": {
+							"open": 107094.51,
+							"high": 107683.67,
+							"low": 106381.12,
+							"close": 107296.79,
+							"volume": 44004218730.21,
+							"market_cap": 2133525441498.32,
+							"timestamp": "2025-06-27T23:59:59.999Z"
+						}
+					}
+				}
+			]
+		}
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestOHLCVHistorical(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v2/cryptocurrency/ohlcv/historical", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			require.NoError(t, preProcessFn(req))
+
+			require.Equal(t,
+				"count=1&id=1&interval=daily&skip_invalid=true&time_period=daily&time_start=2025-06-27T00%3A00%3A00Z",
+				req.URL.RawQuery,
+			)
+
+			return json.Unmarshal([]byte(ohlcvHistoricalResponseBody), result)
+		})
+
+	ohlcv, err := cryptoc.OHLCVHistorical(
+		t.Context(),
+		[]currency.Currency{currency.ID(1)},
+		cryptocurrency.WithOHTimePeriod(cryptocurrency.OHLCVTimePeriodDaily),
+		cryptocurrency.WithOHInterval(types.IntervalDaily),
+		cryptocurrency.WithOHTimeStart(time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC)),
+		cryptocurrency.WithOHCount(1),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, ohlcv.Data["1"].Candles, 1)
+
+	candle := ohlcv.Data["1"].Candles[0]
+	require.Equal(t, time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC), candle.TimeOpen)
+	require.Equal(t, time.Date(2025, time.June, 27, 23, 59, 59, 999000000, time.UTC), candle.TimeClose)
+	require.InDelta(t, 107296.79, candle.Quotes["USD"].Close, 0)
+}
@@ -0,0 +1,131 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	ohlcvLatestEndpoint = "/v2/cryptocurrency/ohlcv/latest"
+)
+
+type OHLCVLatestResponse struct {
+	Data   map[string]OHLCVLatestData `json:"data"`
+	Status types.Status               `json:"status"`
+}
+
+// GetStatus returns response status.
+func (o *OHLCVLatestResponse) GetStatus() types.Status {
+	return o.Status
+}
+
+type OHLCVLatestData struct {
+	ID          int              `json:"id"`
+	Name        string           `json:"name"`
+	Symbol      string           `json:"symbol"`
+	LastUpdated time.Time        `json:"last_updated"`
+	TimeOpen    time.Time        `json:"time_open"`
+	TimeClose   time.Time        `json:"time_close"`
+	TimeHigh    time.Time        `json:"time_high"`
+	TimeLow     time.Time        `json:"time_low"`
+	Quotes      map[string]OHLCV `json:"quote"`
+}
+
+type OHLCV struct {
+	Open        float64   `json:"open"`
+	High        float64   `json:"high"`
+	Low         float64   `json:"low"`
+	Close       float64   `json:"close"`
+	Volume      float64   `json:"volume"`
+	MarketCap   float64   `json:"market_cap"`
+	Timestamp   time.Time `json:"timestamp"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+type ohlcvLatestOptions struct {
+	Convert     []currency.Currency
+	SkipInvalid bool
+}
+
+// OHLCVLatestOption ohlcv latest optional param.
+type OHLCVLatestOption func(opts *ohlcvLatestOptions)
+
+// WithOLConvert calculate market quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithOLConvert(currencies ...currency.Currency) OHLCVLatestOption {
+	return func(opts *ohlcvLatestOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// WithOLSkipInvalid specify request validation rules.
+// If set to true, invalid lookups will be skipped allowing valid cryptocurrencies to still be returned.
+// By default true.
+func WithOLSkipInvalid(skip bool) OHLCVLatestOption {
+	return func(opts *ohlcvLatestOptions) {
+		opts.SkipInvalid = skip
+	}
+}
+
+// OHLCVLatest returns the latest OHLCV (Open, High, Low, Close, Volume) market values
+// for one or more cryptocurrencies for the current UTC day.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV2CryptocurrencyOhlcvLatest
+func (c *Cryptocurrency) OHLCVLatest(
+	ctx context.Context,
+	currencies []currency.Currency,
+	withOpts ...OHLCVLatestOption,
+) (*OHLCVLatestResponse, error) {
+	var (
+		options = ohlcvLatestOptions{
+			SkipInvalid: true,
+		}
+		response OHLCVLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		ohlcvLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeOHLCVLatestQuery(currencies, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeOHLCVLatestQuery(
+	currencies []currency.Currency,
+	options ohlcvLatestOptions,
+) string {
+	query := make(url.Values)
+	urlquery.AddCurrencies(query, currencies)
+
+	urlquery.AddConvert(query, options.Convert)
+
+	query.Add("skip_invalid", strconv.FormatBool(options.SkipInvalid))
+
+	return query.Encode()
+}
@@ -0,0 +1,94 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	ohlcvLatestResponseBody = `
+{
+	"data": {
+		"1": {
+			"id": 1,
+			"name": "Bitcoin",
+			"symbol": "BTC",
+			"last_updated": "2025-06-28T16:18:00.000Z",
+			"time_open": "2025-06-28T00:00:00.000Z",
+			"time_close": null,
+			"time_high": "2025-06-28T02:14:00.000Z",
+			"time_low": "2025-06-28T11:05:00.000Z",
+			"quote": {
+				"2781": {
+					"open": 107296.79,
+					"high": 107593.12,
+					"low": 106875.4,
+					"close": 107120.55,
+					"volume": 21004218730.21,
+					"last_updated": "2025-06-28T16:18:00.000Z"
+				}
+			}
+		}
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestOHLCVLatest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v2/cryptocurrency/ohlcv/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"convert_id=2781&id=1%2C1027&skip_invalid=false",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(ohlcvLatestResponseBody), result)
+		})
+
+	ohlcv, err := cryptoc.OHLCVLatest(
+		t.Context(),
+		[]currency.Currency{currency.ID(1), currency.ID(1027)},
+		cryptocurrency.WithOLConvert(currency.ID(2781)),
+		cryptocurrency.WithOLSkipInvalid(false),
+	)
+
+	require.NoError(t, err)
+
+	btc := ohlcv.Data["1"]
+	require.Equal(t, time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC), btc.TimeOpen)
+	require.True(t, btc.TimeClose.IsZero())
+	require.InDelta(t, 107593.12, btc.Quotes["2781"].High, 0)
+	require.InDelta(t, 107120.55, btc.Quotes["2781"].Close, 0)
+}
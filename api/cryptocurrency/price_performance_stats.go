@@ -0,0 +1,173 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	pricePerformanceStatsEndpoint = "/v2/cryptocurrency/price-performance-stats/latest"
+)
+
+type PricePerformanceStatsResponse struct {
+	Data   map[string]PricePerformanceData `json:"data"`
+	Status types.Status                    `json:"status"`
+}
+
+// GetStatus returns response status.
+func (p *PricePerformanceStatsResponse) GetStatus() types.Status {
+	return p.Status
+}
+
+type PricePerformanceData struct {
+	ID          int                                          `json:"id"`
+	Name        string                                       `json:"name"`
+	Symbol      string                                       `json:"symbol"`
+	Slug        string                                       `json:"slug"`
+	LastUpdated time.Time                                    `json:"last_updated"`
+	Periods     map[PerformancePeriod]PricePerformancePeriod `json:"periods"`
+}
+
+type PricePerformancePeriod struct {
+	OpenTimestamp  time.Time                        `json:"open_timestamp"`
+	HighTimestamp  time.Time                        `json:"high_timestamp"`
+	LowTimestamp   time.Time                        `json:"low_timestamp"`
+	CloseTimestamp time.Time                        `json:"close_timestamp"`
+	Quotes         map[string]PricePerformanceQuote `json:"quote"`
+}
+
+type PricePerformanceQuote struct {
+	Open           float64   `json:"open"`
+	OpenTimestamp  time.Time `json:"open_timestamp"`
+	High           float64   `json:"high"`
+	HighTimestamp  time.Time `json:"high_timestamp"`
+	Low            float64   `json:"low"`
+	LowTimestamp   time.Time `json:"low_timestamp"`
+	Close          float64   `json:"close"`
+	CloseTimestamp time.Time `json:"close_timestamp"`
+	PercentChange  float64   `json:"percent_change"`
+	PriceChange    float64   `json:"price_change"`
+}
+
+type PerformancePeriod string
+
+func (p PerformancePeriod) String() string {
+	return string(p)
+}
+
+const (
+	PerformancePeriodAllTime   PerformancePeriod = "all_time"
+	PerformancePeriodYesterday PerformancePeriod = "yesterday"
+	PerformancePeriod24h       PerformancePeriod = "24h"
+	PerformancePeriod7d        PerformancePeriod = "7d"
+	PerformancePeriod30d       PerformancePeriod = "30d"
+	PerformancePeriod90d       PerformancePeriod = "90d"
+	PerformancePeriod365d      PerformancePeriod = "365d"
+)
+
+type pricePerformanceStatsOptions struct {
+	TimePeriods []PerformancePeriod
+	Convert     []currency.Currency
+	SkipInvalid bool
+}
+
+// PricePerformanceStatsOption price performance stats optional param.
+type PricePerformanceStatsOption func(opts *pricePerformanceStatsOptions)
+
+// WithPPSTimePeriod specify one or more time periods to return stats for.
+// Default "all_time".
+func WithPPSTimePeriod(periods ...PerformancePeriod) PricePerformanceStatsOption {
+	return func(opts *pricePerformanceStatsOptions) {
+		opts.TimePeriods = periods
+	}
+}
+
+// WithPPSConvert calculate quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithPPSConvert(currencies ...currency.Currency) PricePerformanceStatsOption {
+	return func(opts *pricePerformanceStatsOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// WithPPSSkipInvalid specify request validation rules.
+// If set to true, invalid lookups will be skipped allowing valid cryptocurrencies to still be returned.
+// By default true.
+func WithPPSSkipInvalid(skip bool) PricePerformanceStatsOption {
+	return func(opts *pricePerformanceStatsOptions) {
+		opts.SkipInvalid = skip
+	}
+}
+
+// PricePerformanceStats returns price performance statistics for one or more cryptocurrencies
+// including launch price ROI and all-time high / all-time low.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV2CryptocurrencyPriceperformancestatsLatest
+func (c *Cryptocurrency) PricePerformanceStats(
+	ctx context.Context,
+	currencies []currency.Currency,
+	withOpts ...PricePerformanceStatsOption,
+) (*PricePerformanceStatsResponse, error) {
+	var (
+		options = pricePerformanceStatsOptions{
+			SkipInvalid: true,
+		}
+		response PricePerformanceStatsResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		pricePerformanceStatsEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makePricePerformanceStatsQuery(currencies, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makePricePerformanceStatsQuery(
+	currencies []currency.Currency,
+	options pricePerformanceStatsOptions,
+) string {
+	query := make(url.Values)
+	urlquery.AddCurrencies(query, currencies)
+
+	if len(options.TimePeriods) > 0 {
+		periods := make([]string, 0, len(options.TimePeriods))
+
+		for _, period := range options.TimePeriods {
+			periods = append(periods, period.String())
+		}
+
+		query.Add("time_period", urlquery.CommaSeparated(periods))
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	query.Add("skip_invalid", strconv.FormatBool(options.SkipInvalid))
+
+	return query.Encode()
+}
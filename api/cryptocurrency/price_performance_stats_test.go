@@ -0,0 +1,95 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+const (
+	pricePerformanceStatsResponseBody = `
+{
+	"data": {
+		"1": {
+			"id": 1,
+			"name": "Bitcoin",
+			"symbol": "BTC",
+			"slug": "bitcoin",
+			"last_updated": "2025-06-28T16:19:00.000Z",
+			"periods": {
+				"all_time": {
+					"open_timestamp": "2013-04-28T00:00:00.000Z",
+					"high_timestamp": "2025-05-22T18:42:00.000Z",
+					"low_timestamp": "2013-07-05T18:56:01.000Z",
+					"close_timestamp": "2025-06-28T16:19:00.000Z",
+					"quote": {
+						"USD": {
+							"open": 135.3000030517578,
+							"open_timestamp": "2013-04-28T00:00:00.000Z",
+							"high": 111970.17,
+							"high_timestamp": "2025-05-22T18:42:00.000Z",
+							"low": 65.5260009765625,
+							"low_timestamp": "2013-07-05T18:56:01.000Z",
+							"close": 107431.73,
+							"close_timestamp": "2025-06-28T16:19:00.000Z",
+							"percent_change": 79301.4,
+							"price_change": 107296.43
+						}
+					}
+				}
+			}
+		}
+	},
+	"status": {
+		"error_code": 0
+	}
+}
+`
+)
+
+func TestPricePerformanceStats(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v2/cryptocurrency/price-performance-stats/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			require.NoError(t, preProcessFn(req))
+			require.Equal(t, "id=1&skip_invalid=true&time_period=all_time%2C24h", req.URL.RawQuery)
+
+			return json.Unmarshal([]byte(pricePerformanceStatsResponseBody), result)
+		})
+
+	stats, err := cryptoc.PricePerformanceStats(
+		t.Context(),
+		[]currency.Currency{currency.ID(1)},
+		cryptocurrency.WithPPSTimePeriod(cryptocurrency.PerformancePeriodAllTime, cryptocurrency.PerformancePeriod24h),
+	)
+
+	require.NoError(t, err)
+
+	allTime, ok := stats.Data["1"].Periods[cryptocurrency.PerformancePeriodAllTime]
+	require.True(t, ok)
+	require.Equal(t, time.Date(2025, time.May, 22, 18, 42, 0, 0, time.UTC), allTime.HighTimestamp)
+	require.InDelta(t, 111970.17, allTime.Quotes["USD"].High, 0)
+}
@@ -0,0 +1,181 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	quotesHistoricalEndpoint = "/v2/cryptocurrency/quotes/historical"
+)
+
+type QuotesHistoricalResponse struct {
+	Data   map[string]QuotesHistoricalData `json:"data"`
+	Status types.Status                    `json:"status"`
+}
+
+// GetStatus returns response status.
+func (q *QuotesHistoricalResponse) GetStatus() types.Status {
+	return q.Status
+}
+
+type QuotesHistoricalData struct {
+	ID       int                     `json:"id"`
+	Name     string                  `json:"name"`
+	Symbol   string                  `json:"symbol"`
+	IsActive int                     `json:"is_active"`
+	IsFiat   int                     `json:"is_fiat"`
+	Quotes   []QuotesHistoricalPoint `json:"quotes"`
+}
+
+type QuotesHistoricalPoint struct {
+	Timestamp time.Time        `json:"timestamp"`
+	Quotes    map[string]Quote `json:"quote"`
+}
+
+type quotesHistoricalOptions struct {
+	TimeStart   time.Time
+	TimeEnd     time.Time
+	Count       int
+	Interval    types.Interval
+	Convert     []currency.Currency
+	Aux         []string
+	SkipInvalid bool
+}
+
+// QuotesHistoricalOption quotes historical optional param.
+type QuotesHistoricalOption func(opts *quotesHistoricalOptions)
+
+// WithQHTimeStart timestamp to start returning quotes for.
+// Optional, if not passed, we'll return quotes calculated in reverse from "time_end".
+func WithQHTimeStart(start time.Time) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.TimeStart = start
+	}
+}
+
+// WithQHTimeEnd timestamp to stop returning quotes for (inclusive).
+// Optional, if not passed, we'll default to the current time.
+func WithQHTimeEnd(end time.Time) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.TimeEnd = end
+	}
+}
+
+// WithQHCount the number of interval periods to return results for.
+// Default 10.
+func WithQHCount(count int) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Count = count
+	}
+}
+
+// WithQHInterval interval of time to return data points for.
+// Default "5m".
+func WithQHInterval(interval types.Interval) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Interval = interval
+	}
+}
+
+// WithQHConvert calculate quotes in up to 3 other currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithQHConvert(currencies ...currency.Currency) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// WithQHAux specify a list of supplemental data fields to return.
+// By default "price,volume,market_cap,circulating_supply,total_supply,quote_timestamp,is_active,is_fiat".
+func WithQHAux(fields ...string) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Aux = fields
+	}
+}
+
+// WithQHSkipInvalid specify request validation rules.
+// If set to true, invalid lookups will be skipped allowing valid cryptocurrencies to still be returned.
+// By default true.
+func WithQHSkipInvalid(skip bool) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.SkipInvalid = skip
+	}
+}
+
+// QuotesHistorical returns an interval of historic market quotes for any cryptocurrency based on time and interval.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV2CryptocurrencyQuotesHistorical
+func (c *Cryptocurrency) QuotesHistorical(
+	ctx context.Context,
+	currencies []currency.Currency,
+	withOpts ...QuotesHistoricalOption,
+) (*QuotesHistoricalResponse, error) {
+	var (
+		options = quotesHistoricalOptions{
+			SkipInvalid: true,
+		}
+		response QuotesHistoricalResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		quotesHistoricalEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeQuotesHistoricalQuery(currencies, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeQuotesHistoricalQuery(
+	currencies []currency.Currency,
+	options quotesHistoricalOptions,
+) string {
+	query := make(url.Values)
+	urlquery.AddCurrencies(query, currencies)
+
+	urlquery.AddTime(query, "time_start", options.TimeStart)
+	urlquery.AddTime(query, "time_end", options.TimeEnd)
+
+	if options.Count > 0 {
+		query.Add("count", strconv.Itoa(options.Count))
+	}
+
+	if options.Interval != "" {
+		query.Add("interval", options.Interval.String())
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	query.Add("skip_invalid", strconv.FormatBool(options.SkipInvalid))
+
+	return query.Encode()
+}
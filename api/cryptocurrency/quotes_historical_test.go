@@ -0,0 +1,100 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	quotesHistoricalResponseBody = `
+{
+	"data": {
+		"1": {
+			"id": 1,
+			"name": "Bitcoin",
+			"symbol": "BTC",
+			"is_active": 1,
+			"is_fiat": 0,
+			"quotes": [
+				{
+					"timestamp": "2025-06-27T00:00:00.000Z",
+					"quote": {
+						"USD": {
+							"price": 107094.51,
+							"volume_24h": 44004218730.21,
+							"market_cap": 2133525441498.32,
+							"last_updated": "2025-06-27T00:00:00.000Z"
+						}
+					}
+				}
+			]
+		}
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestQuotesHistorical(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v2/cryptocurrency/quotes/historical", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"aux=price%2Cvolume&convert=USD%2CEUR&count=2&id=1"+
+					"&interval=daily&skip_invalid=false&time_end=2025-06-28T00%3A00%3A00Z&time_start=2025-06-27T00%3A00%3A00Z",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(quotesHistoricalResponseBody), result)
+		})
+
+	quotes, err := cryptoc.QuotesHistorical(
+		t.Context(),
+		[]currency.Currency{currency.ID(1)},
+		cryptocurrency.WithQHTimeStart(time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC)),
+		cryptocurrency.WithQHTimeEnd(time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC)),
+		cryptocurrency.WithQHCount(2),
+		cryptocurrency.WithQHInterval(types.IntervalDaily),
+		cryptocurrency.WithQHConvert(currency.Symbol("USD"), currency.Symbol("EUR")),
+		cryptocurrency.WithQHAux("price", "volume"),
+		cryptocurrency.WithQHSkipInvalid(false),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, quotes.Data["1"].Quotes, 1)
+
+	point := quotes.Data["1"].Quotes[0]
+	require.Equal(t, time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC), point.Timestamp)
+	require.InDelta(t, 107094.51, point.Quotes["USD"].Price, 0)
+}
@@ -6,17 +6,16 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/Mikhalevich/coinmarketcap"
 	"github.com/Mikhalevich/coinmarketcap/api/types"
 	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
 )
 
 const (
 	quoteLatestEndpoint = "/v2/cryptocurrency/quotes/latest"
-	comma               = ","
 )
 
 type QuotesLatestResponse struct {
@@ -24,6 +23,11 @@ type QuotesLatestResponse struct {
 	Status types.Status               `json:"status"`
 }
 
+// GetStatus returns response status.
+func (q *QuotesLatestResponse) GetStatus() types.Status {
+	return q.Status
+}
+
 type QuoteLatestData struct {
 	ID                            int              `json:"id"`
 	Name                          string           `json:"name"`
@@ -95,6 +99,8 @@ func quotePrice(data QuoteLatestData, baseSymbol string) float64 {
 type quotesLatestOptions struct {
 	Aux         []string
 	SkipInvalid bool
+	BatchSize   int
+	Concurrency int
 }
 
 // QuotesLatestOption quotes latest optional param.
@@ -120,7 +126,27 @@ func WithQLAux(fields ...string) QuotesLatestOption {
 	}
 }
 
+// WithQLBatchSize maximum number of currencies requested at once.
+// Larger currency lists are split into batches and merged into a single response.
+// Non-positive size disables splitting.
+// Default 100.
+func WithQLBatchSize(size int) QuotesLatestOption {
+	return func(opts *quotesLatestOptions) {
+		opts.BatchSize = size
+	}
+}
+
+// WithQLConcurrency maximum number of batches requested in parallel.
+// Default 1.
+func WithQLConcurrency(concurrency int) QuotesLatestOption {
+	return func(opts *quotesLatestOptions) {
+		opts.Concurrency = concurrency
+	}
+}
+
 // QuotesLatest returns the latest market quote for 1 or more cryptocurrencies.
+// If currencies exceed batch size they are requested in batches, in case some of the batches failed
+// *PartialError containing response with data of succeeded batches is returned.
 // https://coinmarketcap.com/api/documentation/v1/#operation/getV2CryptocurrencyQuotesLatest
 func (c *Cryptocurrency) QuotesLatest(
 	ctx context.Context,
@@ -128,17 +154,63 @@ func (c *Cryptocurrency) QuotesLatest(
 	convertTo []currency.Currency,
 	withOpts ...QuotesLatestOption,
 ) (*QuotesLatestResponse, error) {
-	var (
-		options = quotesLatestOptions{
-			SkipInvalid: true,
-		}
-		quotes QuotesLatestResponse
-	)
+	options := quotesLatestOptions{
+		SkipInvalid: true,
+		BatchSize:   defaultBatchSize,
+		Concurrency: defaultConcurrency,
+	}
 
 	for _, option := range withOpts {
 		option(&options)
 	}
 
+	if options.BatchSize <= 0 || len(convertFrom) <= options.BatchSize {
+		return c.quotesLatest(ctx, convertFrom, convertTo, options)
+	}
+
+	merged, succeeded, failed := requestBatches(
+		ctx,
+		convertFrom,
+		options.BatchSize,
+		options.Concurrency,
+		func(ctx context.Context, chunk []currency.Currency) (batchResponse[QuoteLatestData], error) {
+			rsp, err := c.quotesLatest(ctx, chunk, convertTo, options)
+			if err != nil {
+				return batchResponse[QuoteLatestData]{}, err
+			}
+
+			return batchResponse[QuoteLatestData]{Data: rsp.Data, Status: rsp.Status}, nil
+		},
+	)
+
+	response := &QuotesLatestResponse{
+		Data:   merged.Data,
+		Status: merged.Status,
+	}
+
+	if len(failed) > 0 {
+		partialErr := &PartialError[QuotesLatestResponse]{
+			Batches: failed,
+		}
+
+		if succeeded {
+			partialErr.Response = response
+		}
+
+		return nil, partialErr
+	}
+
+	return response, nil
+}
+
+func (c *Cryptocurrency) quotesLatest(
+	ctx context.Context,
+	convertFrom []currency.Currency,
+	convertTo []currency.Currency,
+	options quotesLatestOptions,
+) (*QuotesLatestResponse, error) {
+	var quotes QuotesLatestResponse
+
 	if err := c.executor.Get(
 		ctx,
 		quoteLatestEndpoint,
@@ -165,108 +237,14 @@ func makeQuotesLatestQuery(
 	options quotesLatestOptions,
 ) string {
 	query := make(url.Values)
-	query.Add(makeConvertToQueryKey(to), makeCommaSeparatedValues(convertCurrenciesToQueryKey(to)))
-	query.Add(makeCurrencyQueryKey(from), makeCommaSeparatedValues(convertCurrenciesToQueryKey(from)))
+	query.Add(urlquery.ConvertKey(to), urlquery.CommaSeparated(urlquery.CurrencyValues(to)))
+	urlquery.AddCurrencies(query, from)
 
 	if len(options.Aux) > 0 {
-		query.Add("aux", makeCommaSeparatedValues(options.Aux))
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
 	}
 
 	query.Add("skip_invalid", strconv.FormatBool(options.SkipInvalid))
 
 	return query.Encode()
 }
-
-func makeCurrencyQueryKey(from []currency.Currency) string {
-	if len(from) == 0 {
-		return ""
-	}
-
-	if from[0].ID != "" {
-		return "id"
-	}
-
-	if from[0].Symbol != "" {
-		return "symbol"
-	}
-
-	if from[0].Slug != "" {
-		return "slug"
-	}
-
-	return ""
-}
-
-func makeConvertToQueryKey(from []currency.Currency) string {
-	if len(from) == 0 {
-		return ""
-	}
-
-	if from[0].ID != "" {
-		return "convert_id"
-	}
-
-	if from[0].Symbol != "" {
-		return "convert"
-	}
-
-	return ""
-}
-
-func convertCurrenciesToQueryKey(from []currency.Currency) []string {
-	if len(from) == 0 {
-		return nil
-	}
-
-	values := make([]string, 0, len(from))
-
-	for _, curr := range from {
-		if curr.ID != "" {
-			values = append(values, curr.ID)
-
-			continue
-		}
-
-		if curr.Symbol != "" {
-			values = append(values, curr.Symbol)
-
-			continue
-		}
-
-		if curr.Slug != "" {
-			values = append(values, curr.Slug)
-		}
-	}
-
-	return values
-}
-
-func makeCommaSeparatedValues(currencies []string) string {
-	switch len(currencies) {
-	case 0:
-		return ""
-	case 1:
-		return currencies[0]
-	}
-
-	var bufLen int
-
-	bufLen += len(comma)*len(currencies) - 1
-
-	for _, curr := range currencies {
-		bufLen += len(curr)
-	}
-
-	var builder strings.Builder
-
-	builder.Grow(bufLen)
-
-	builder.WriteString(currencies[0])
-
-	for _, curr := range currencies[1:] {
-		builder.WriteString(comma)
-		builder.WriteString(curr)
-	}
-
-	return builder.String()
-}
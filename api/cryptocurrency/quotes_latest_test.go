@@ -1,7 +1,9 @@
 package cryptocurrency_test
 
 import (
+	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -39,3 +41,69 @@ func TestQuotesLatestExecutorError(t *testing.T) {
 	require.Nil(t, quotesRsp)
 	require.EqualError(t, err, "execute get request: some executor error")
 }
+
+func TestQuotesLatestBatches(t *testing.T) {
+	t.Parallel()
+
+	const (
+		usdID = 2781
+	)
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+		cryptoc      = cryptocurrency.New(mockExecutor)
+		errBatch     = errors.New("batch executor error")
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v2/cryptocurrency/quotes/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			require.NoError(t, preProcessFn(req))
+
+			rsp, ok := result.(*cryptocurrency.QuotesLatestResponse)
+			require.True(t, ok)
+
+			switch req.URL.Query().Get("id") {
+			case "1,2":
+				rsp.Data = map[string]cryptocurrency.QuoteLatestData{"1": {ID: 1}, "2": {ID: 2}}
+				rsp.Status.CreditCount = 1
+			case "3,4":
+				return errBatch
+			case "5":
+				rsp.Data = map[string]cryptocurrency.QuoteLatestData{"5": {ID: 5}}
+				rsp.Status.CreditCount = 1
+			default:
+				t.Errorf("unexpected query: %s", req.URL.RawQuery)
+			}
+
+			return nil
+		}).
+		Times(3)
+
+	quotesRsp, err := cryptoc.QuotesLatest(
+		t.Context(),
+		[]currency.Currency{currency.ID(1), currency.ID(2), currency.ID(3), currency.ID(4), currency.ID(5)},
+		[]currency.Currency{currency.ID(usdID)},
+		cryptocurrency.WithQLBatchSize(2),
+		cryptocurrency.WithQLConcurrency(2),
+	)
+
+	require.Nil(t, quotesRsp)
+
+	var partialErr *cryptocurrency.PartialError[cryptocurrency.QuotesLatestResponse]
+	require.ErrorAs(t, err, &partialErr)
+	require.NotNil(t, partialErr.Response)
+	require.Len(t, partialErr.Response.Data, 3)
+	require.Equal(t, 2, partialErr.Response.Status.CreditCount)
+	require.Len(t, partialErr.Batches, 1)
+	require.Equal(t, []currency.Currency{currency.ID(3), currency.ID(4)}, partialErr.Batches[0].Currencies)
+	require.ErrorIs(t, err, errBatch)
+}
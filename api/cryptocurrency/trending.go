@@ -0,0 +1,193 @@
+package cryptocurrency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	trendingLatestEndpoint        = "/v1/cryptocurrency/trending/latest"
+	trendingMostVisitedEndpoint   = "/v1/cryptocurrency/trending/most-visited"
+	trendingGainersLosersEndpoint = "/v1/cryptocurrency/trending/gainers-losers"
+)
+
+type TrendingResponse struct {
+	Data   []ListingData `json:"data"`
+	Status types.Status  `json:"status"`
+}
+
+// GetStatus returns response status.
+func (t *TrendingResponse) GetStatus() types.Status {
+	return t.Status
+}
+
+type TrendingTimePeriod string
+
+func (t TrendingTimePeriod) String() string {
+	return string(t)
+}
+
+const (
+	// TrendingTimePeriod1h supported by gainers and losers only.
+	TrendingTimePeriod1h  TrendingTimePeriod = "1h"
+	TrendingTimePeriod24h TrendingTimePeriod = "24h"
+	TrendingTimePeriod7d  TrendingTimePeriod = "7d"
+	TrendingTimePeriod30d TrendingTimePeriod = "30d"
+)
+
+type trendingOptions struct {
+	Start      int
+	Limit      int
+	TimePeriod TrendingTimePeriod
+	SortDir    types.SortDir
+	Convert    []currency.Currency
+}
+
+// TrendingOption trending optional param.
+type TrendingOption func(opts *trendingOptions)
+
+// WithTrendingStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithTrendingStart(start int) TrendingOption {
+	return func(opts *trendingOptions) {
+		opts.Start = start
+	}
+}
+
+// WithTrendingLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+// Default 100.
+func WithTrendingLimit(limit int) TrendingOption {
+	return func(opts *trendingOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithTrendingTimePeriod adjusts the overall window of time for the trending data.
+// Default "24h".
+func WithTrendingTimePeriod(period TrendingTimePeriod) TrendingOption {
+	return func(opts *trendingOptions) {
+		opts.TimePeriod = period
+	}
+}
+
+// WithTrendingSortDir the direction in which to order gainers and losers.
+// Ignored by latest and most visited endpoints.
+// Default "desc".
+func WithTrendingSortDir(dir types.SortDir) TrendingOption {
+	return func(opts *trendingOptions) {
+		opts.SortDir = dir
+	}
+}
+
+// WithTrendingConvert calculate market quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithTrendingConvert(currencies ...currency.Currency) TrendingOption {
+	return func(opts *trendingOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// TrendingLatest returns a paginated list of all trending cryptocurrency market data,
+// determined and sorted by CoinMarketCap search volume.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CryptocurrencyTrendingLatest
+func (c *Cryptocurrency) TrendingLatest(
+	ctx context.Context,
+	withOpts ...TrendingOption,
+) (*TrendingResponse, error) {
+	return c.trending(ctx, trendingLatestEndpoint, false, withOpts)
+}
+
+// TrendingMostVisited returns a paginated list of all trending cryptocurrency market data,
+// determined and sorted by traffic to coin detail pages.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CryptocurrencyTrendingMostvisited
+func (c *Cryptocurrency) TrendingMostVisited(
+	ctx context.Context,
+	withOpts ...TrendingOption,
+) (*TrendingResponse, error) {
+	return c.trending(ctx, trendingMostVisitedEndpoint, false, withOpts)
+}
+
+// TrendingGainersLosers returns a paginated list of all trending cryptocurrencies,
+// determined and sorted by the largest price gains or losses.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1CryptocurrencyTrendingGainerslosers
+func (c *Cryptocurrency) TrendingGainersLosers(
+	ctx context.Context,
+	withOpts ...TrendingOption,
+) (*TrendingResponse, error) {
+	return c.trending(ctx, trendingGainersLosersEndpoint, true, withOpts)
+}
+
+func (c *Cryptocurrency) trending(
+	ctx context.Context,
+	endpoint string,
+	sortable bool,
+	withOpts []TrendingOption,
+) (*TrendingResponse, error) {
+	var (
+		options = trendingOptions{
+			Start: 1,
+		}
+		response TrendingResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if !sortable {
+		options.SortDir = ""
+	}
+
+	if err := c.executor.Get(
+		ctx,
+		endpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeTrendingQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeTrendingQuery(options trendingOptions) string {
+	query := make(url.Values)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	if options.TimePeriod != "" {
+		query.Add("time_period", options.TimePeriod.String())
+	}
+
+	if options.SortDir != "" {
+		query.Add("sort_dir", options.SortDir.String())
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	return query.Encode()
+}
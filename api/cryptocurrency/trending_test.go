@@ -0,0 +1,85 @@
+package cryptocurrency_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+func TestTrendingQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		endpoint string
+		call     func(
+			c *cryptocurrency.Cryptocurrency,
+			ctx context.Context,
+			opts ...cryptocurrency.TrendingOption,
+		) (*cryptocurrency.TrendingResponse, error)
+		rawQuery string
+	}{
+		{
+			name:     "latest",
+			endpoint: "/v1/cryptocurrency/trending/latest",
+			call:     (*cryptocurrency.Cryptocurrency).TrendingLatest,
+			rawQuery: "limit=5&start=1&time_period=7d",
+		},
+		{
+			name:     "most visited",
+			endpoint: "/v1/cryptocurrency/trending/most-visited",
+			call:     (*cryptocurrency.Cryptocurrency).TrendingMostVisited,
+			rawQuery: "limit=5&start=1&time_period=7d",
+		},
+		{
+			name:     "gainers losers",
+			endpoint: "/v1/cryptocurrency/trending/gainers-losers",
+			call:     (*cryptocurrency.Cryptocurrency).TrendingGainersLosers,
+			rawQuery: "limit=5&sort_dir=asc&start=1&time_period=7d",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctrl         = gomock.NewController(t)
+				mockExecutor = cryptocurrency.NewMockExecutor(ctrl)
+				cryptoc      = cryptocurrency.New(mockExecutor)
+			)
+
+			mockExecutor.EXPECT().
+				Get(t.Context(), test.endpoint, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(
+					ctx context.Context,
+					path string,
+					preProcessFn func(req *http.Request) error,
+					result any,
+				) error {
+					req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+					require.NoError(t, err)
+					require.NoError(t, preProcessFn(req))
+					require.Equal(t, test.rawQuery, req.URL.RawQuery)
+
+					return nil
+				})
+
+			_, err := test.call(
+				cryptoc,
+				t.Context(),
+				cryptocurrency.WithTrendingLimit(5),
+				cryptocurrency.WithTrendingTimePeriod(cryptocurrency.TrendingTimePeriod7d),
+				cryptocurrency.WithTrendingSortDir(types.SortDirAsc),
+			)
+
+			require.NoError(t, err)
+		})
+	}
+}
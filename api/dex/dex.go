@@ -0,0 +1,97 @@
+package dex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap/contract"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+// ErrNetworkMismatch returned when requested pairs belong to different networks.
+var ErrNetworkMismatch = errors.New("pairs belong to different networks")
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+type Dex struct {
+	executor Executor
+}
+
+func New(executor Executor) *Dex {
+	return &Dex{
+		executor: executor,
+	}
+}
+
+// Pair dex pair metadata.
+type Pair struct {
+	ContractAddress           string    `json:"contract_address"`
+	Name                      string    `json:"name"`
+	BaseAssetID               string    `json:"base_asset_id"`
+	BaseAssetUCID             string    `json:"base_asset_ucid"`
+	BaseAssetName             string    `json:"base_asset_name"`
+	BaseAssetSymbol           string    `json:"base_asset_symbol"`
+	BaseAssetContractAddress  string    `json:"base_asset_contract_address"`
+	QuoteAssetID              string    `json:"quote_asset_id"`
+	QuoteAssetUCID            string    `json:"quote_asset_ucid"`
+	QuoteAssetName            string    `json:"quote_asset_name"`
+	QuoteAssetSymbol          string    `json:"quote_asset_symbol"`
+	QuoteAssetContractAddress string    `json:"quote_asset_contract_address"`
+	DexID                     string    `json:"dex_id"`
+	DexSlug                   string    `json:"dex_slug"`
+	NetworkID                 string    `json:"network_id"`
+	NetworkSlug               string    `json:"network_slug"`
+	LastUpdated               time.Time `json:"last_updated"`
+	CreatedAt                 time.Time `json:"created_at"`
+}
+
+func addNetworkQuery(query url.Values, network contract.Network) {
+	if network.ID != "" {
+		query.Add("network_id", network.ID)
+
+		return
+	}
+
+	if network.Slug != "" {
+		query.Add("network_slug", network.Slug)
+	}
+}
+
+// checkPairsNetwork checks that all pairs belong to the network of the first pair.
+func checkPairsNetwork(pairs []contract.Contract) error {
+	for _, pair := range pairs {
+		if pair.Network != pairs[0].Network {
+			return fmt.Errorf("%w: %+v and %+v", ErrNetworkMismatch, pairs[0].Network, pair.Network)
+		}
+	}
+
+	return nil
+}
+
+// addPairsQuery adds pair contract addresses with network of the first pair.
+// Pairs should be checked with checkPairsNetwork before.
+func addPairsQuery(query url.Values, pairs []contract.Contract) {
+	if len(pairs) == 0 {
+		return
+	}
+
+	addresses := make([]string, 0, len(pairs))
+
+	for _, pair := range pairs {
+		addresses = append(addresses, pair.Address)
+	}
+
+	query.Add("contract_address", urlquery.CommaSeparated(addresses))
+	addNetworkQuery(query, pairs[0].Network)
+}
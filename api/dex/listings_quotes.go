@@ -0,0 +1,215 @@
+package dex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	listingsQuotesEndpoint = "/v4/dex/listings/quotes"
+)
+
+type ListingsQuotesResponse struct {
+	Data   []ListingData `json:"data"`
+	Status types.Status  `json:"status"`
+}
+
+// GetStatus returns response status.
+func (l *ListingsQuotesResponse) GetStatus() types.Status {
+	return l.Status
+}
+
+type ListingData struct {
+	ID             int            `json:"id"`
+	Name           string         `json:"name"`
+	Slug           string         `json:"slug"`
+	Status         string         `json:"status"`
+	Type           string         `json:"type"`
+	Logo           string         `json:"logo"`
+	NumMarketPairs int            `json:"num_market_pairs"`
+	MarketShare    float64        `json:"market_share"`
+	LastUpdated    time.Time      `json:"last_updated"`
+	Quotes         []ListingQuote `json:"quote"`
+}
+
+type ListingQuote struct {
+	ConvertID              string    `json:"convert_id"`
+	Volume24h              float64   `json:"volume_24h"`
+	PercentChangeVolume24h float64   `json:"percent_change_volume_24h"`
+	NumTransactions24h     int       `json:"num_transactions_24h"`
+	LastUpdated            time.Time `json:"last_updated"`
+}
+
+type ListingSortField string
+
+func (l ListingSortField) String() string {
+	return string(l)
+}
+
+const (
+	ListingSortVolume24h   ListingSortField = "volume_24h"
+	ListingSortName        ListingSortField = "name"
+	ListingSortMarketShare ListingSortField = "market_share"
+	ListingSortNumMarkets  ListingSortField = "num_markets"
+)
+
+type ListingType string
+
+func (l ListingType) String() string {
+	return string(l)
+}
+
+const (
+	ListingTypeAll        ListingType = "all"
+	ListingTypeOrderbook  ListingType = "orderbook"
+	ListingTypeSwap       ListingType = "swap"
+	ListingTypeAggregator ListingType = "aggregator"
+)
+
+type listingsQuotesOptions struct {
+	Start   int
+	Limit   int
+	Sort    ListingSortField
+	SortDir types.SortDir
+	Type    ListingType
+	Aux     []string
+	Convert []currency.Currency
+}
+
+// ListingsQuotesOption listings quotes optional param.
+type ListingsQuotesOption func(opts *listingsQuotesOptions)
+
+// WithLQStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithLQStart(start int) ListingsQuotesOption {
+	return func(opts *listingsQuotesOptions) {
+		opts.Start = start
+	}
+}
+
+// WithLQLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+func WithLQLimit(limit int) ListingsQuotesOption {
+	return func(opts *listingsQuotesOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithLQSort what field to sort the list of dexes by.
+// Default "volume_24h".
+func WithLQSort(field ListingSortField) ListingsQuotesOption {
+	return func(opts *listingsQuotesOptions) {
+		opts.Sort = field
+	}
+}
+
+// WithLQSortDir the direction in which to order dexes against the specified sort.
+// Default "desc".
+func WithLQSortDir(dir types.SortDir) ListingsQuotesOption {
+	return func(opts *listingsQuotesOptions) {
+		opts.SortDir = dir
+	}
+}
+
+// WithLQType the type of dex to include.
+// Default "all".
+func WithLQType(listingType ListingType) ListingsQuotesOption {
+	return func(opts *listingsQuotesOptions) {
+		opts.Type = listingType
+	}
+}
+
+// WithLQAux specify a list of supplemental data fields to return.
+// Valid values "url,logo,description,date_launched,notice".
+func WithLQAux(fields ...string) ListingsQuotesOption {
+	return func(opts *listingsQuotesOptions) {
+		opts.Aux = fields
+	}
+}
+
+// WithLQConvert calculate market quotes in other currencies.
+// Currencies should be specified by id.
+// Default "2781" (USD).
+func WithLQConvert(currencies ...currency.Currency) ListingsQuotesOption {
+	return func(opts *listingsQuotesOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// ListingsQuotes returns a paginated list of all dexes with latest market data.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV4DexListingsQuotes
+func (d *Dex) ListingsQuotes(
+	ctx context.Context,
+	withOpts ...ListingsQuotesOption,
+) (*ListingsQuotesResponse, error) {
+	var (
+		options = listingsQuotesOptions{
+			Start: 1,
+		}
+		response ListingsQuotesResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := d.executor.Get(
+		ctx,
+		listingsQuotesEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeListingsQuotesQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeListingsQuotesQuery(options listingsQuotesOptions) string {
+	query := make(url.Values)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	if options.Sort != "" {
+		query.Add("sort", options.Sort.String())
+	}
+
+	if options.SortDir != "" {
+		query.Add("sort_dir", options.SortDir.String())
+	}
+
+	if options.Type != "" {
+		query.Add("type", options.Type.String())
+	}
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	return query.Encode()
+}
@@ -0,0 +1,163 @@
+package dex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	networksEndpoint = "/v4/dex/networks/list"
+)
+
+type NetworksResponse struct {
+	Data   []NetworkData `json:"data"`
+	Status types.Status  `json:"status"`
+}
+
+// GetStatus returns response status.
+func (n *NetworksResponse) GetStatus() types.Status {
+	return n.Status
+}
+
+type NetworkData struct {
+	ID                 int       `json:"id"`
+	Name               string    `json:"name"`
+	NetworkSlug        string    `json:"network_slug"`
+	CryptocurrencyID   int       `json:"cryptocurrency_id"`
+	CryptocurrencySlug string    `json:"cryptocurrency_slug"`
+	WrappedTokenID     int       `json:"wrapped_token_id"`
+	WrappedTokenSlug   string    `json:"wrapped_token_slug"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+type NetworkSortField string
+
+func (n NetworkSortField) String() string {
+	return string(n)
+}
+
+const (
+	NetworkSortID   NetworkSortField = "id"
+	NetworkSortName NetworkSortField = "name"
+)
+
+type networksOptions struct {
+	Start   int
+	Limit   int
+	Sort    NetworkSortField
+	SortDir types.SortDir
+	Aux     []string
+}
+
+// NetworksOption networks optional param.
+type NetworksOption func(opts *networksOptions)
+
+// WithNetworksStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithNetworksStart(start int) NetworksOption {
+	return func(opts *networksOptions) {
+		opts.Start = start
+	}
+}
+
+// WithNetworksLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+func WithNetworksLimit(limit int) NetworksOption {
+	return func(opts *networksOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithNetworksSort what field to sort the list of networks by.
+// Default "id".
+func WithNetworksSort(field NetworkSortField) NetworksOption {
+	return func(opts *networksOptions) {
+		opts.Sort = field
+	}
+}
+
+// WithNetworksSortDir the direction in which to order networks against the specified sort.
+func WithNetworksSortDir(dir types.SortDir) NetworksOption {
+	return func(opts *networksOptions) {
+		opts.SortDir = dir
+	}
+}
+
+// WithNetworksAux specify a list of supplemental data fields to return.
+// Valid values "alternativeName,cryptocurrencyId,cryptocurrenySlug,wrappedTokenId,wrappedTokenSlug".
+func WithNetworksAux(fields ...string) NetworksOption {
+	return func(opts *networksOptions) {
+		opts.Aux = fields
+	}
+}
+
+// Networks returns a list of all networks to unique CoinMarketCap ids.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV4DexNetworksList
+func (d *Dex) Networks(
+	ctx context.Context,
+	withOpts ...NetworksOption,
+) (*NetworksResponse, error) {
+	var (
+		options = networksOptions{
+			Start: 1,
+		}
+		response NetworksResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := d.executor.Get(
+		ctx,
+		networksEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeNetworksQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeNetworksQuery(options networksOptions) string {
+	query := make(url.Values)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	if options.Sort != "" {
+		query.Add("sort", options.Sort.String())
+	}
+
+	if options.SortDir != "" {
+		query.Add("sort_dir", options.SortDir.String())
+	}
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	return query.Encode()
+}
@@ -0,0 +1,230 @@
+package dex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	pairOHLCVHistoricalEndpoint = "/v4/dex/pairs/ohlcv/historical"
+)
+
+type PairOHLCVHistoricalResponse struct {
+	Data   []PairOHLCVHistoricalData `json:"data"`
+	Status types.Status              `json:"status"`
+}
+
+// GetStatus returns response status.
+func (p *PairOHLCVHistoricalResponse) GetStatus() types.Status {
+	return p.Status
+}
+
+type PairOHLCVHistoricalData struct {
+	Pair
+
+	Candles []PairCandle `json:"quotes"`
+}
+
+type PairCandle struct {
+	TimeOpen  time.Time `json:"time_open"`
+	TimeClose time.Time `json:"time_close"`
+	Quotes    []OHLCV   `json:"quote"`
+}
+
+type OHLCVTimePeriod string
+
+func (o OHLCVTimePeriod) String() string {
+	return string(o)
+}
+
+const (
+	OHLCVTimePeriodDaily  OHLCVTimePeriod = "daily"
+	OHLCVTimePeriodHourly OHLCVTimePeriod = "hourly"
+	OHLCVTimePeriod1m     OHLCVTimePeriod = "1m"
+	OHLCVTimePeriod5m     OHLCVTimePeriod = "5m"
+	OHLCVTimePeriod15m    OHLCVTimePeriod = "15m"
+	OHLCVTimePeriod30m    OHLCVTimePeriod = "30m"
+	OHLCVTimePeriod4h     OHLCVTimePeriod = "4h"
+	OHLCVTimePeriod8h     OHLCVTimePeriod = "8h"
+	OHLCVTimePeriod12h    OHLCVTimePeriod = "12h"
+	OHLCVTimePeriodWeekly OHLCVTimePeriod = "weekly"
+)
+
+type pairOHLCVHistoricalOptions struct {
+	TimePeriod   OHLCVTimePeriod
+	TimeStart    time.Time
+	TimeEnd      time.Time
+	Count        int
+	Interval     types.Interval
+	Aux          []string
+	Convert      []currency.Currency
+	SkipInvalid  bool
+	ReverseOrder bool
+}
+
+// PairOHLCVHistoricalOption pair ohlcv historical optional param.
+type PairOHLCVHistoricalOption func(opts *pairOHLCVHistoricalOptions)
+
+// WithPOHTimePeriod time period to return OHLCV data for.
+// Default "daily".
+func WithPOHTimePeriod(period OHLCVTimePeriod) PairOHLCVHistoricalOption {
+	return func(opts *pairOHLCVHistoricalOptions) {
+		opts.TimePeriod = period
+	}
+}
+
+// WithPOHTimeStart timestamp to start returning OHLCV time periods for.
+// Optional, if not passed, we'll return quotes calculated in reverse from "time_end".
+func WithPOHTimeStart(start time.Time) PairOHLCVHistoricalOption {
+	return func(opts *pairOHLCVHistoricalOptions) {
+		opts.TimeStart = start
+	}
+}
+
+// WithPOHTimeEnd timestamp to stop returning OHLCV time periods for (inclusive).
+// Optional, if not passed, we'll default to the current time.
+func WithPOHTimeEnd(end time.Time) PairOHLCVHistoricalOption {
+	return func(opts *pairOHLCVHistoricalOptions) {
+		opts.TimeEnd = end
+	}
+}
+
+// WithPOHCount limit the number of time periods to return results for.
+// Default 10.
+func WithPOHCount(count int) PairOHLCVHistoricalOption {
+	return func(opts *pairOHLCVHistoricalOptions) {
+		opts.Count = count
+	}
+}
+
+// WithPOHInterval interval of time to return data points for.
+// Default "daily".
+func WithPOHInterval(interval types.Interval) PairOHLCVHistoricalOption {
+	return func(opts *pairOHLCVHistoricalOptions) {
+		opts.Interval = interval
+	}
+}
+
+// WithPOHAux specify a list of supplemental data fields to return.
+func WithPOHAux(fields ...string) PairOHLCVHistoricalOption {
+	return func(opts *pairOHLCVHistoricalOptions) {
+		opts.Aux = fields
+	}
+}
+
+// WithPOHConvert calculate market quotes in other currencies.
+// Currencies should be specified by id.
+// Default "2781" (USD).
+func WithPOHConvert(currencies ...currency.Currency) PairOHLCVHistoricalOption {
+	return func(opts *pairOHLCVHistoricalOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// WithPOHSkipInvalid specify request validation rules.
+// If set to true, invalid lookups will be skipped allowing valid pairs to still be returned.
+// By default true.
+func WithPOHSkipInvalid(skip bool) PairOHLCVHistoricalOption {
+	return func(opts *pairOHLCVHistoricalOptions) {
+		opts.SkipInvalid = skip
+	}
+}
+
+// WithPOHReverseOrder invert the order of base and quote assets in the pair.
+// Default false.
+func WithPOHReverseOrder(reverse bool) PairOHLCVHistoricalOption {
+	return func(opts *pairOHLCVHistoricalOptions) {
+		opts.ReverseOrder = reverse
+	}
+}
+
+// PairOHLCVHistorical returns historical OHLCV (Open, High, Low, Close, Volume) data
+// along with market cap for any spot pair using time interval parameters.
+// All pairs should belong to the same network, otherwise ErrNetworkMismatch is returned.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV4DexPairsOhlcvHistorical
+func (d *Dex) PairOHLCVHistorical(
+	ctx context.Context,
+	pairs []contract.Contract,
+	withOpts ...PairOHLCVHistoricalOption,
+) (*PairOHLCVHistoricalResponse, error) {
+	var (
+		options = pairOHLCVHistoricalOptions{
+			SkipInvalid: true,
+		}
+		response PairOHLCVHistoricalResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := checkPairsNetwork(pairs); err != nil {
+		return nil, err
+	}
+
+	if err := d.executor.Get(
+		ctx,
+		pairOHLCVHistoricalEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makePairOHLCVHistoricalQuery(pairs, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makePairOHLCVHistoricalQuery(
+	pairs []contract.Contract,
+	options pairOHLCVHistoricalOptions,
+) string {
+	query := make(url.Values)
+	addPairsQuery(query, pairs)
+
+	if options.TimePeriod != "" {
+		query.Add("time_period", options.TimePeriod.String())
+	}
+
+	urlquery.AddTime(query, "time_start", options.TimeStart)
+	urlquery.AddTime(query, "time_end", options.TimeEnd)
+
+	if options.Count > 0 {
+		query.Add("count", strconv.Itoa(options.Count))
+	}
+
+	if options.Interval != "" {
+		query.Add("interval", options.Interval.String())
+	}
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	query.Add("skip_invalid", strconv.FormatBool(options.SkipInvalid))
+
+	if options.ReverseOrder {
+		query.Add("reverse_order", strconv.FormatBool(options.ReverseOrder))
+	}
+
+	return query.Encode()
+}
@@ -0,0 +1,118 @@
+package dex_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/dex"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	pairOHLCVHistoricalResponseBody = `
+{
+	"data": [
+		{
+			"contract_address": "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640",
+			"name": "USDC/WETH",
+			"network_slug": "Ethereum",
+			"quotes": [
+				{
+					"time_open": "2025-06-27T00:00:00.000Z",
+					"time_close": "2025-06-27T23:59:59.999Z",
+					"quote": [
+						{
+							"convert_id": "2781",
+							"open": 0.9995,
+							"high": 1.0004,
+							"low": 0.9990,
+							"close": 0.9997,
+							"volume": 111111111.11,
+							"timestamp": "2025-06-27T23:59:59.999Z"
+						}
+					]
+				},
+				{
+					"time_open": "2025-06-28T00:00:00.000Z",
+					"time_close": "2025-06-28T23:59:59.999Z",
+					"quote": [
+						{
+							"convert_id": "2781",
+							"open": 0.9997,
+							"high": 1.0003,
+							"low": 0.9991,
+							"close": 0.9998,
+							"volume": 123456789.12,
+							"timestamp": "2025-06-28T23:59:59.999Z"
+						}
+					]
+				}
+			]
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-29T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestPairOHLCVHistorical(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = dex.NewMockExecutor(ctrl)
+		dx           = dex.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v4/dex/pairs/ohlcv/historical", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"contract_address=0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640&count=2&interval=daily"+
+					"&network_slug=ethereum&skip_invalid=true&time_end=2025-06-29T00%3A00%3A00Z"+
+					"&time_period=daily&time_start=2025-06-27T00%3A00%3A00Z",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(pairOHLCVHistoricalResponseBody), result)
+		})
+
+	ohlcv, err := dx.PairOHLCVHistorical(
+		t.Context(),
+		[]contract.Contract{
+			contract.New("0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640", contract.NetworkSlug("ethereum")),
+		},
+		dex.WithPOHTimePeriod(dex.OHLCVTimePeriodDaily),
+		dex.WithPOHTimeStart(time.Date(2025, 6, 27, 0, 0, 0, 0, time.UTC)),
+		dex.WithPOHTimeEnd(time.Date(2025, 6, 29, 0, 0, 0, 0, time.UTC)),
+		dex.WithPOHCount(2),
+		dex.WithPOHInterval(types.IntervalDaily),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, ohlcv.Data, 1)
+	require.Len(t, ohlcv.Data[0].Candles, 2)
+	require.Equal(t, time.Date(2025, 6, 28, 0, 0, 0, 0, time.UTC), ohlcv.Data[0].Candles[1].TimeOpen)
+	require.Len(t, ohlcv.Data[0].Candles[1].Quotes, 1)
+	require.InDelta(t, 0.9998, ohlcv.Data[0].Candles[1].Quotes[0].Close, 0)
+}
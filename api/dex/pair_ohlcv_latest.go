@@ -0,0 +1,89 @@
+package dex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+)
+
+const (
+	pairOHLCVLatestEndpoint = "/v4/dex/pairs/ohlcv/latest"
+)
+
+type PairOHLCVLatestResponse struct {
+	Data   []PairOHLCVLatestData `json:"data"`
+	Status types.Status          `json:"status"`
+}
+
+// GetStatus returns response status.
+func (p *PairOHLCVLatestResponse) GetStatus() types.Status {
+	return p.Status
+}
+
+type PairOHLCVLatestData struct {
+	Pair
+
+	TimeOpen  time.Time `json:"time_open"`
+	TimeClose time.Time `json:"time_close"`
+	Quotes    []OHLCV   `json:"quote"`
+}
+
+type OHLCV struct {
+	ConvertID   string    `json:"convert_id"`
+	Open        float64   `json:"open"`
+	High        float64   `json:"high"`
+	Low         float64   `json:"low"`
+	Close       float64   `json:"close"`
+	Volume      float64   `json:"volume"`
+	Timestamp   time.Time `json:"timestamp"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// PairOHLCVLatest returns the latest OHLCV (Open, High, Low, Close, Volume) market values
+// for one or more spot pairs for the current UTC day.
+// All pairs should belong to the same network, otherwise ErrNetworkMismatch is returned.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV4DexPairsOhlcvLatest
+func (d *Dex) PairOHLCVLatest(
+	ctx context.Context,
+	pairs []contract.Contract,
+	withOpts ...PairOption,
+) (*PairOHLCVLatestResponse, error) {
+	var (
+		options = pairOptions{
+			SkipInvalid: true,
+		}
+		response PairOHLCVLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := checkPairsNetwork(pairs); err != nil {
+		return nil, err
+	}
+
+	if err := d.executor.Get(
+		ctx,
+		pairOHLCVLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makePairQuery(pairs, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
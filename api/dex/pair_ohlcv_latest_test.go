@@ -0,0 +1,96 @@
+package dex_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/dex"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	pairOHLCVLatestResponseBody = `
+{
+	"data": [
+		{
+			"contract_address": "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640",
+			"name": "USDC/WETH",
+			"network_slug": "Ethereum",
+			"time_open": "2025-06-28T00:00:00.000Z",
+			"time_close": "2025-06-28T23:59:59.999Z",
+			"quote": [
+				{
+					"convert_id": "2781",
+					"open": 0.9997,
+					"high": 1.0003,
+					"low": 0.9991,
+					"close": 0.9998,
+					"volume": 123456789.12,
+					"timestamp": "2025-06-28T16:19:00.000Z"
+				}
+			]
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestPairOHLCVLatest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = dex.NewMockExecutor(ctrl)
+		dx           = dex.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v4/dex/pairs/ohlcv/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"contract_address=0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640&convert_id=2781"+
+					"&network_id=1&reverse_order=true&skip_invalid=false",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(pairOHLCVLatestResponseBody), result)
+		})
+
+	ohlcv, err := dx.PairOHLCVLatest(
+		t.Context(),
+		[]contract.Contract{
+			contract.New("0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640", contract.NetworkID(1)),
+		},
+		dex.WithPairConvert(currency.ID(2781)),
+		dex.WithPairSkipInvalid(false),
+		dex.WithPairReverseOrder(true),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, ohlcv.Data, 1)
+	require.Equal(t, time.Date(2025, 6, 28, 0, 0, 0, 0, time.UTC), ohlcv.Data[0].TimeOpen)
+	require.Len(t, ohlcv.Data[0].Quotes, 1)
+	require.InDelta(t, 1.0003, ohlcv.Data[0].Quotes[0].High, 0)
+	require.InDelta(t, 0.9991, ohlcv.Data[0].Quotes[0].Low, 0)
+}
@@ -0,0 +1,72 @@
+package dex
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/Mikhalevich/coinmarketcap/contract"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+type pairOptions struct {
+	Aux          []string
+	Convert      []currency.Currency
+	SkipInvalid  bool
+	ReverseOrder bool
+}
+
+// PairOption optional param for pair quotes, ohlcv and trades latest endpoints.
+type PairOption func(opts *pairOptions)
+
+// WithPairAux specify a list of supplemental data fields to return.
+func WithPairAux(fields ...string) PairOption {
+	return func(opts *pairOptions) {
+		opts.Aux = fields
+	}
+}
+
+// WithPairConvert calculate market quotes in other currencies.
+// Currencies should be specified by id.
+// Default "2781" (USD).
+func WithPairConvert(currencies ...currency.Currency) PairOption {
+	return func(opts *pairOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// WithPairSkipInvalid specify request validation rules.
+// If set to true, invalid lookups will be skipped allowing valid pairs to still be returned.
+// By default true.
+func WithPairSkipInvalid(skip bool) PairOption {
+	return func(opts *pairOptions) {
+		opts.SkipInvalid = skip
+	}
+}
+
+// WithPairReverseOrder invert the order of base and quote assets in the pair.
+// Default false.
+func WithPairReverseOrder(reverse bool) PairOption {
+	return func(opts *pairOptions) {
+		opts.ReverseOrder = reverse
+	}
+}
+
+func makePairQuery(pairs []contract.Contract, options pairOptions) string {
+	query := make(url.Values)
+	addPairsQuery(query, pairs)
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	query.Add("skip_invalid", strconv.FormatBool(options.SkipInvalid))
+
+	if options.ReverseOrder {
+		query.Add("reverse_order", strconv.FormatBool(options.ReverseOrder))
+	}
+
+	return query.Encode()
+}
@@ -0,0 +1,74 @@
+package dex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+)
+
+const (
+	pairQuotesLatestEndpoint = "/v4/dex/pairs/quotes/latest"
+)
+
+type PairQuotesLatestResponse struct {
+	Data   []PairQuotesData `json:"data"`
+	Status types.Status     `json:"status"`
+}
+
+// GetStatus returns response status.
+func (p *PairQuotesLatestResponse) GetStatus() types.Status {
+	return p.Status
+}
+
+type PairQuotesData struct {
+	Pair
+
+	Quotes []PairQuote `json:"quote"`
+}
+
+// PairQuotesLatest returns the latest market quote for 1 or more spot pairs.
+// All pairs should belong to the same network, otherwise ErrNetworkMismatch is returned.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV4DexPairsQuotesLatest
+func (d *Dex) PairQuotesLatest(
+	ctx context.Context,
+	pairs []contract.Contract,
+	withOpts ...PairOption,
+) (*PairQuotesLatestResponse, error) {
+	var (
+		options = pairOptions{
+			SkipInvalid: true,
+		}
+		response PairQuotesLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := checkPairsNetwork(pairs); err != nil {
+		return nil, err
+	}
+
+	if err := d.executor.Get(
+		ctx,
+		pairQuotesLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makePairQuery(pairs, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
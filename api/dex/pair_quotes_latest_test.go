@@ -0,0 +1,119 @@
+package dex_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/dex"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+const (
+	pairQuotesLatestResponseBody = `
+{
+	"data": [
+		{
+			"contract_address": "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640",
+			"name": "USDC/WETH",
+			"base_asset_symbol": "USDC",
+			"quote_asset_symbol": "WETH",
+			"dex_slug": "uniswap-v3",
+			"network_slug": "Ethereum",
+			"quote": [
+				{
+					"convert_id": "2781",
+					"price": 0.9998,
+					"price_by_quote_asset": 0.00041,
+					"volume_24h": 123456789.12,
+					"liquidity": 98765432.1,
+					"last_updated": "2025-06-28T16:19:00.000Z"
+				}
+			]
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestPairQuotesLatest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = dex.NewMockExecutor(ctrl)
+		dx           = dex.New(mockExecutor)
+		ethereum     = contract.NetworkSlug("ethereum")
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v4/dex/pairs/quotes/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			require.NoError(t, preProcessFn(req))
+
+			require.Equal(t,
+				"contract_address=0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640%2C0x11b815efb8f581194ae79006d24e0d814b7697f6"+
+					"&convert_id=2781&network_slug=ethereum&skip_invalid=true",
+				req.URL.RawQuery,
+			)
+
+			return json.Unmarshal([]byte(pairQuotesLatestResponseBody), result)
+		})
+
+	quotes, err := dx.PairQuotesLatest(
+		t.Context(),
+		[]contract.Contract{
+			contract.New("0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640", ethereum),
+			contract.New("0x11b815efb8f581194ae79006d24e0d814b7697f6", ethereum),
+		},
+		dex.WithPairConvert(currency.ID(2781)),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, quotes.Data, 1)
+	require.Equal(t, "USDC/WETH", quotes.Data[0].Name)
+	require.Len(t, quotes.Data[0].Quotes, 1)
+	require.Equal(t, "2781", quotes.Data[0].Quotes[0].ConvertID)
+	require.InDelta(t, 0.9998, quotes.Data[0].Quotes[0].Price, 0)
+}
+
+func TestPairQuotesLatestNetworkMismatch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = dex.NewMockExecutor(ctrl)
+		dx           = dex.New(mockExecutor)
+	)
+
+	quotes, err := dx.PairQuotesLatest(
+		t.Context(),
+		[]contract.Contract{
+			contract.New("0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640", contract.NetworkSlug("ethereum")),
+			contract.New("0x11b815efb8f581194ae79006d24e0d814b7697f6", contract.NetworkSlug("bsc")),
+		},
+	)
+
+	require.ErrorIs(t, err, dex.ErrNetworkMismatch)
+	require.Nil(t, quotes)
+}
@@ -0,0 +1,91 @@
+package dex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+)
+
+const (
+	pairTradesLatestEndpoint = "/v4/dex/pairs/trade/latest"
+)
+
+type PairTradesLatestResponse struct {
+	Data   []PairTradesData `json:"data"`
+	Status types.Status     `json:"status"`
+}
+
+// GetStatus returns response status.
+func (p *PairTradesLatestResponse) GetStatus() types.Status {
+	return p.Status
+}
+
+type PairTradesData struct {
+	Pair
+
+	Trades []Trade `json:"trades"`
+}
+
+type Trade struct {
+	Date            time.Time    `json:"date"`
+	Type            string       `json:"type"`
+	TransactionHash string       `json:"transaction_hash"`
+	Quotes          []TradeQuote `json:"quote"`
+}
+
+type TradeQuote struct {
+	ConvertID         string  `json:"convert_id"`
+	Price             float64 `json:"price"`
+	Total             float64 `json:"total"`
+	PriceByQuoteAsset float64 `json:"price_by_quote_asset"`
+	AmountBaseAsset   float64 `json:"amount_base_asset"`
+	AmountQuoteAsset  float64 `json:"amount_quote_asset"`
+}
+
+// PairTradesLatest returns up to the latest 100 trades for 1 or more spot pairs.
+// All pairs should belong to the same network, otherwise ErrNetworkMismatch is returned.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV4DexPairsTradeLatest
+func (d *Dex) PairTradesLatest(
+	ctx context.Context,
+	pairs []contract.Contract,
+	withOpts ...PairOption,
+) (*PairTradesLatestResponse, error) {
+	var (
+		options = pairOptions{
+			SkipInvalid: true,
+		}
+		response PairTradesLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := checkPairsNetwork(pairs); err != nil {
+		return nil, err
+	}
+
+	if err := d.executor.Get(
+		ctx,
+		pairTradesLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makePairQuery(pairs, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,96 @@
+package dex_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/dex"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	pairTradesLatestResponseBody = `
+{
+	"data": [
+		{
+			"contract_address": "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640",
+			"name": "USDC/WETH",
+			"network_slug": "Ethereum",
+			"trades": [
+				{
+					"date": "2025-06-28T16:18:47.000Z",
+					"type": "buy",
+					"transaction_hash": "0x5f1c0b4a6f0e5b0b2e1d7c3a9b8f6e4d2c1a0b9f8e7d6c5b4a3f2e1d0c9b8a7f",
+					"quote": [
+						{
+							"convert_id": "2781",
+							"price": 0.9998,
+							"total": 1999.6,
+							"amount_base_asset": 2000,
+							"amount_quote_asset": 0.82
+						}
+					]
+				}
+			]
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestPairTradesLatest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = dex.NewMockExecutor(ctrl)
+		dx           = dex.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v4/dex/pairs/trade/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"aux=transaction_hash%2Cblockchain_explorer_link"+
+					"&contract_address=0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640"+
+					"&network_slug=ethereum&skip_invalid=true",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(pairTradesLatestResponseBody), result)
+		})
+
+	trades, err := dx.PairTradesLatest(
+		t.Context(),
+		[]contract.Contract{
+			contract.New("0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640", contract.NetworkSlug("ethereum")),
+		},
+		dex.WithPairAux("transaction_hash", "blockchain_explorer_link"),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, trades.Data, 1)
+	require.Len(t, trades.Data[0].Trades, 1)
+	require.Equal(t, "buy", trades.Data[0].Trades[0].Type)
+	require.Len(t, trades.Data[0].Trades[0].Quotes, 1)
+	require.InDelta(t, 1999.6, trades.Data[0].Trades[0].Quotes[0].Total, 0)
+}
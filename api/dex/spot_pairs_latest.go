@@ -0,0 +1,265 @@
+package dex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	spotPairsLatestEndpoint = "/v4/dex/spot-pairs/latest"
+)
+
+type SpotPairsLatestResponse struct {
+	Data   []SpotPairData `json:"data"`
+	Status types.Status   `json:"status"`
+}
+
+// GetStatus returns response status.
+func (s *SpotPairsLatestResponse) GetStatus() types.Status {
+	return s.Status
+}
+
+type SpotPairData struct {
+	Pair
+
+	ScrollID string      `json:"scroll_id"`
+	Quotes   []PairQuote `json:"quote"`
+}
+
+type PairQuote struct {
+	ConvertID             string    `json:"convert_id"`
+	Price                 float64   `json:"price"`
+	PriceByQuoteAsset     float64   `json:"price_by_quote_asset"`
+	Volume24h             float64   `json:"volume_24h"`
+	PercentChangePrice1h  float64   `json:"percent_change_price_1h"`
+	PercentChangePrice24h float64   `json:"percent_change_price_24h"`
+	Liquidity             float64   `json:"liquidity"`
+	FullyDilutedValue     float64   `json:"fully_diluted_value"`
+	LastUpdated           time.Time `json:"last_updated"`
+}
+
+type SpotPairSortField string
+
+func (s SpotPairSortField) String() string {
+	return string(s)
+}
+
+const (
+	SpotPairSortName                SpotPairSortField = "name"
+	SpotPairSortDateAdded           SpotPairSortField = "date_added"
+	SpotPairSortPrice               SpotPairSortField = "price"
+	SpotPairSortVolume24h           SpotPairSortField = "volume_24h"
+	SpotPairSortPercentChange1h     SpotPairSortField = "percent_change_1h"
+	SpotPairSortPercentChange24h    SpotPairSortField = "percent_change_24h"
+	SpotPairSortLiquidity           SpotPairSortField = "liquidity"
+	SpotPairSortFullyDilutedValue   SpotPairSortField = "fully_diluted_value"
+	SpotPairSortNoOfTransactions24h SpotPairSortField = "no_of_transactions_24h"
+)
+
+type spotPairsLatestOptions struct {
+	DexID                     string
+	DexSlug                   string
+	BaseAssetContractAddress  string
+	QuoteAssetContractAddress string
+	ScrollID                  string
+	Limit                     int
+	LiquidityMin              *float64
+	Volume24hMin              *float64
+	Sort                      SpotPairSortField
+	SortDir                   types.SortDir
+	Aux                       []string
+	Convert                   []currency.Currency
+}
+
+// SpotPairsLatestOption spot pairs latest optional param.
+type SpotPairsLatestOption func(opts *spotPairsLatestOptions)
+
+// WithSPDexID filter pairs by dex id.
+func WithSPDexID(id int) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.DexID = strconv.Itoa(id)
+	}
+}
+
+// WithSPDexSlug filter pairs by dex slug.
+func WithSPDexSlug(slug string) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.DexSlug = slug
+	}
+}
+
+// WithSPBaseAsset filter pairs by base asset contract address.
+func WithSPBaseAsset(asset contract.Contract) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.BaseAssetContractAddress = asset.Address
+	}
+}
+
+// WithSPQuoteAsset filter pairs by quote asset contract address.
+func WithSPQuoteAsset(asset contract.Contract) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.QuoteAssetContractAddress = asset.Address
+	}
+}
+
+// WithSPScrollID pagination cursor returned with the last item of the previous page.
+func WithSPScrollID(scrollID string) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.ScrollID = scrollID
+	}
+}
+
+// WithSPLimit specify the number of results to return.
+// Default 100.
+func WithSPLimit(limit int) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithSPLiquidityMin threshold of minimum liquidity to filter results by.
+func WithSPLiquidityMin(liquidity float64) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.LiquidityMin = &liquidity
+	}
+}
+
+// WithSPVolume24hMin threshold of minimum 24 hour volume to filter results by.
+func WithSPVolume24hMin(volume float64) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.Volume24hMin = &volume
+	}
+}
+
+// WithSPSort what field to sort the list of pairs by.
+// Default "volume_24h".
+func WithSPSort(field SpotPairSortField) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.Sort = field
+	}
+}
+
+// WithSPSortDir the direction in which to order pairs against the specified sort.
+// Default "desc".
+func WithSPSortDir(dir types.SortDir) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.SortDir = dir
+	}
+}
+
+// WithSPAux specify a list of supplemental data fields to return.
+// Valid values "pool_created,percent_pooled_base_asset,num_transactions_24h,pool_base_asset,
+// pool_quote_asset,24h_volume_quote_asset,total_supply_quote_asset,total_supply_base_asset,
+// holders,buy_tax,sell_tax,security_scan,24h_no_of_buys,24h_no_of_sells,24h_buy_volume,24h_sell_volume".
+func WithSPAux(fields ...string) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.Aux = fields
+	}
+}
+
+// WithSPConvert calculate market quotes in other currencies.
+// Currencies should be specified by id.
+// Default "2781" (USD).
+func WithSPConvert(currencies ...currency.Currency) SpotPairsLatestOption {
+	return func(opts *spotPairsLatestOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// SpotPairsLatest returns a paginated list of all active dex spot pairs with latest market data
+// for the specified network.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV4DexSpotpairsLatest
+func (d *Dex) SpotPairsLatest(
+	ctx context.Context,
+	network contract.Network,
+	withOpts ...SpotPairsLatestOption,
+) (*SpotPairsLatestResponse, error) {
+	var (
+		options  spotPairsLatestOptions
+		response SpotPairsLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := d.executor.Get(
+		ctx,
+		spotPairsLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeSpotPairsLatestQuery(network, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeSpotPairsLatestQuery(
+	network contract.Network,
+	options spotPairsLatestOptions,
+) string {
+	query := make(url.Values)
+	addNetworkQuery(query, network)
+
+	if options.DexID != "" {
+		query.Add("dex_id", options.DexID)
+	}
+
+	if options.DexSlug != "" {
+		query.Add("dex_slug", options.DexSlug)
+	}
+
+	if options.BaseAssetContractAddress != "" {
+		query.Add("base_asset_contract_address", options.BaseAssetContractAddress)
+	}
+
+	if options.QuoteAssetContractAddress != "" {
+		query.Add("quote_asset_contract_address", options.QuoteAssetContractAddress)
+	}
+
+	if options.ScrollID != "" {
+		query.Add("scroll_id", options.ScrollID)
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	urlquery.AddFloat(query, "liquidity_min", options.LiquidityMin)
+	urlquery.AddFloat(query, "volume_24h_min", options.Volume24hMin)
+
+	if options.Sort != "" {
+		query.Add("sort", options.Sort.String())
+	}
+
+	if options.SortDir != "" {
+		query.Add("sort_dir", options.SortDir.String())
+	}
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	return query.Encode()
+}
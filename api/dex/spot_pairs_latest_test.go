@@ -0,0 +1,101 @@
+package dex_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/dex"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	spotPairsLatestResponseBody = `
+{
+	"data": [
+		{
+			"contract_address": "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640",
+			"name": "USDC/WETH",
+			"base_asset_symbol": "USDC",
+			"quote_asset_symbol": "WETH",
+			"dex_slug": "uniswap-v3",
+			"network_slug": "Ethereum",
+			"scroll_id": "1a2b3c",
+			"quote": [
+				{
+					"convert_id": "2781",
+					"price": 0.9998,
+					"volume_24h": 123456789.12,
+					"liquidity": 98765432.1,
+					"last_updated": "2025-06-28T16:19:00.000Z"
+				}
+			]
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestSpotPairsLatest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = dex.NewMockExecutor(ctrl)
+		dx           = dex.New(mockExecutor)
+		ethereum     = contract.NetworkSlug("ethereum")
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v4/dex/spot-pairs/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"base_asset_contract_address=0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48&convert_id=2781"+
+					"&dex_slug=uniswap-v3&limit=10&liquidity_min=1000&network_slug=ethereum&scroll_id=1a2b3c"+
+					"&sort=liquidity&sort_dir=asc",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(spotPairsLatestResponseBody), result)
+		})
+
+	pairs, err := dx.SpotPairsLatest(
+		t.Context(),
+		ethereum,
+		dex.WithSPDexSlug("uniswap-v3"),
+		dex.WithSPBaseAsset(contract.New("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", ethereum)),
+		dex.WithSPScrollID("1a2b3c"),
+		dex.WithSPLimit(10),
+		dex.WithSPLiquidityMin(1000),
+		dex.WithSPSort(dex.SpotPairSortLiquidity),
+		dex.WithSPSortDir(types.SortDirAsc),
+		dex.WithSPConvert(currency.ID(2781)),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, pairs.Data, 1)
+	require.Equal(t, "USDC/WETH", pairs.Data[0].Name)
+	require.Equal(t, "1a2b3c", pairs.Data[0].ScrollID)
+	require.Len(t, pairs.Data[0].Quotes, 1)
+	require.InDelta(t, 98765432.1, pairs.Data[0].Quotes[0].Liquidity, 0)
+}
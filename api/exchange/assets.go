@@ -0,0 +1,79 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+const (
+	assetsEndpoint = "/v1/exchange/assets"
+)
+
+type AssetsResponse struct {
+	Data   []AssetData  `json:"data"`
+	Status types.Status `json:"status"`
+}
+
+// GetStatus returns response status.
+func (a *AssetsResponse) GetStatus() types.Status {
+	return a.Status
+}
+
+type AssetData struct {
+	WalletAddress string        `json:"wallet_address"`
+	Balance       float64       `json:"balance"`
+	Platform      AssetPlatform `json:"platform"`
+	Currency      AssetCurrency `json:"currency"`
+}
+
+type AssetPlatform struct {
+	CryptoID int    `json:"crypto_id"`
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+}
+
+type AssetCurrency struct {
+	CryptoID int     `json:"crypto_id"`
+	PriceUSD float64 `json:"price_usd"`
+	Symbol   string  `json:"symbol"`
+	Name     string  `json:"name"`
+}
+
+// Assets returns the exchange assets in the form of token holdings in each of its wallets.
+// Exchange could be specified by id only.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ExchangeAssets
+func (e *Exchange) Assets(ctx context.Context, exchangeID int) (*AssetsResponse, error) {
+	var response AssetsResponse
+
+	if err := e.executor.Get(
+		ctx,
+		assetsEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeAssetsQuery(exchangeID)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeAssetsQuery(exchangeID int) string {
+	query := make(url.Values)
+	query.Add("id", strconv.Itoa(exchangeID))
+
+	return query.Encode()
+}
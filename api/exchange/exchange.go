@@ -0,0 +1,25 @@
+package exchange
+
+import (
+	"context"
+	"net/http"
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+type Exchange struct {
+	executor Executor
+}
+
+func New(executor Executor) *Exchange {
+	return &Exchange{
+		executor: executor,
+	}
+}
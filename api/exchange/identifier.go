@@ -0,0 +1,60 @@
+package exchange
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+// Identifier struct for exchange representation.
+type Identifier struct {
+	ID   string
+	Slug string
+}
+
+// ID create exchange identifier from id.
+func ID(id int) Identifier {
+	return Identifier{
+		ID: strconv.Itoa(id),
+	}
+}
+
+// Slug create exchange identifier from slug.
+func Slug(slug string) Identifier {
+	return Identifier{
+		Slug: slug,
+	}
+}
+
+// addIdentifiersQuery adds identifiers under id or slug key depending on the first identifier.
+// Empty identifiers are skipped, nothing is added if all identifiers are empty.
+func addIdentifiersQuery(query url.Values, identifiers []Identifier) {
+	var (
+		key    string
+		values = make([]string, 0, len(identifiers))
+	)
+
+	for _, identifier := range identifiers {
+		switch {
+		case identifier.ID != "":
+			values = append(values, identifier.ID)
+
+			if key == "" {
+				key = "id"
+			}
+		case identifier.Slug != "":
+			values = append(values, identifier.Slug)
+
+			if key == "" {
+				key = "slug"
+			}
+		}
+	}
+
+	if len(values) == 0 {
+		return
+	}
+
+	query.Add(key, urlquery.CommaSeparated(values))
+}
@@ -0,0 +1,120 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	infoEndpoint = "/v1/exchange/info"
+)
+
+type InfoResponse struct {
+	Data   map[string]InfoData `json:"data"`
+	Status types.Status        `json:"status"`
+}
+
+// GetStatus returns response status.
+func (i *InfoResponse) GetStatus() types.Status {
+	return i.Status
+}
+
+type InfoData struct {
+	ID                    int       `json:"id"`
+	Name                  string    `json:"name"`
+	Slug                  string    `json:"slug"`
+	Logo                  string    `json:"logo"`
+	Description           string    `json:"description"`
+	DateLaunched          time.Time `json:"date_launched"`
+	Notice                string    `json:"notice"`
+	Countries             []string  `json:"countries"`
+	Fiats                 []string  `json:"fiats"`
+	Tags                  []any     `json:"tags"`
+	Type                  string    `json:"type"`
+	MakerFee              float64   `json:"maker_fee"`
+	TakerFee              float64   `json:"taker_fee"`
+	WeeklyVisits          int       `json:"weekly_visits"`
+	SpotVolumeUSD         float64   `json:"spot_volume_usd"`
+	SpotVolumeLastUpdated time.Time `json:"spot_volume_last_updated"`
+	Urls                  InfoUrls  `json:"urls"`
+}
+
+type InfoUrls struct {
+	Website []string `json:"website"`
+	Twitter []string `json:"twitter"`
+	Blog    []string `json:"blog"`
+	Chat    []string `json:"chat"`
+	Fee     []string `json:"fee"`
+}
+
+type infoOptions struct {
+	Aux []string
+}
+
+// InfoOption info optional param.
+type InfoOption func(opts *infoOptions)
+
+// WithInfoAux specify a list of supplemental data fields to return.
+// By default "urls,logo,description,date_launched,notice".
+func WithInfoAux(fields ...string) InfoOption {
+	return func(opts *infoOptions) {
+		opts.Aux = fields
+	}
+}
+
+// Info returns all static metadata for one or more exchanges.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ExchangeInfo
+func (e *Exchange) Info(
+	ctx context.Context,
+	exchanges []Identifier,
+	withOpts ...InfoOption,
+) (*InfoResponse, error) {
+	var (
+		options  infoOptions
+		response InfoResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := e.executor.Get(
+		ctx,
+		infoEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeInfoQuery(exchanges, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeInfoQuery(
+	exchanges []Identifier,
+	options infoOptions,
+) string {
+	query := make(url.Values)
+	addIdentifiersQuery(query, exchanges)
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	return query.Encode()
+}
@@ -0,0 +1,113 @@
+package exchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/exchange"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	infoResponseBody = `
+{
+	"data": {
+		"270": {
+			"id": 270,
+			"name": "Binance",
+			"slug": "binance",
+			"date_launched": "2017-07-14T00:00:00.000Z",
+			"countries": [],
+			"fiats": ["EUR", "USD"],
+			"type": "",
+			"maker_fee": 0.02,
+			"taker_fee": 0.04,
+			"spot_volume_usd": 12104281123.5,
+			"urls": {
+				"website": ["https://www.binance.com/"],
+				"twitter": ["https://twitter.com/binance"]
+			}
+		}
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestInfo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		exchanges     []exchange.Identifier
+		expectedQuery string
+	}{
+		{
+			name:          "ids",
+			exchanges:     []exchange.Identifier{exchange.ID(270), exchange.ID(294)},
+			expectedQuery: "aux=urls%2Cdate_launched&id=270%2C294",
+		},
+		{
+			name:          "slugs",
+			exchanges:     []exchange.Identifier{exchange.Slug("binance"), exchange.Slug("okx")},
+			expectedQuery: "aux=urls%2Cdate_launched&slug=binance%2Cokx",
+		},
+		{
+			name:          "empty identifiers skipped",
+			exchanges:     []exchange.Identifier{{}, exchange.ID(270), {}},
+			expectedQuery: "aux=urls%2Cdate_launched&id=270",
+		},
+		{
+			name:          "all identifiers empty",
+			exchanges:     []exchange.Identifier{{}},
+			expectedQuery: "aux=urls%2Cdate_launched",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctrl         = gomock.NewController(t)
+				mockExecutor = exchange.NewMockExecutor(ctrl)
+				exch         = exchange.New(mockExecutor)
+			)
+
+			mockExecutor.EXPECT().
+				Get(t.Context(), "/v1/exchange/info", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(
+					ctx context.Context,
+					path string,
+					preProcessFn func(req *http.Request) error,
+					result any,
+				) error {
+					require.Equal(t, tc.expectedQuery, querytest.RawQuery(t, ctx, preProcessFn))
+
+					return json.Unmarshal([]byte(infoResponseBody), result)
+				})
+
+			info, err := exch.Info(t.Context(), tc.exchanges, exchange.WithInfoAux("urls", "date_launched"))
+
+			require.NoError(t, err)
+
+			binance := info.Data["270"]
+			require.Equal(t, "binance", binance.Slug)
+			require.Equal(t, []string{"EUR", "USD"}, binance.Fiats)
+			require.InDelta(t, 0.04, binance.TakerFee, 0)
+			require.Equal(t, []string{"https://www.binance.com/"}, binance.Urls.Website)
+		})
+	}
+}
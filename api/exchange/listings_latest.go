@@ -0,0 +1,248 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	listingsLatestEndpoint = "/v1/exchange/listings/latest"
+)
+
+type ListingsLatestResponse struct {
+	Data   []ListingData `json:"data"`
+	Status types.Status  `json:"status"`
+}
+
+// GetStatus returns response status.
+func (l *ListingsLatestResponse) GetStatus() types.Status {
+	return l.Status
+}
+
+type ListingData struct {
+	ID             int              `json:"id"`
+	Name           string           `json:"name"`
+	Slug           string           `json:"slug"`
+	NumMarketPairs int              `json:"num_market_pairs"`
+	Fiats          []string         `json:"fiats"`
+	TrafficScore   float64          `json:"traffic_score"`
+	Rank           int              `json:"rank"`
+	ExchangeScore  float64          `json:"exchange_score"`
+	LiquidityScore float64          `json:"liquidity_score"`
+	LastUpdated    time.Time        `json:"last_updated"`
+	Quotes         map[string]Quote `json:"quote"`
+}
+
+type Quote struct {
+	Volume24h              float64   `json:"volume_24h"`
+	Volume24hAdjusted      float64   `json:"volume_24h_adjusted"`
+	Volume7d               float64   `json:"volume_7d"`
+	Volume30d              float64   `json:"volume_30d"`
+	PercentChangeVolume24h float64   `json:"percent_change_volume_24h"`
+	PercentChangeVolume7d  float64   `json:"percent_change_volume_7d"`
+	PercentChangeVolume30d float64   `json:"percent_change_volume_30d"`
+	EffectiveLiquidity24h  float64   `json:"effective_liquidity_24h"`
+	DerivativeVolumeUSD    float64   `json:"derivative_volume_usd"`
+	SpotVolumeUSD          float64   `json:"spot_volume_usd"`
+	Timestamp              time.Time `json:"timestamp"`
+}
+
+type ListingSortField string
+
+func (l ListingSortField) String() string {
+	return string(l)
+}
+
+const (
+	ListingSortName              ListingSortField = "name"
+	ListingSortVolume24h         ListingSortField = "volume_24h"
+	ListingSortVolume24hAdjusted ListingSortField = "volume_24h_adjusted"
+	ListingSortExchangeScore     ListingSortField = "exchange_score"
+)
+
+type MarketType string
+
+func (m MarketType) String() string {
+	return string(m)
+}
+
+const (
+	MarketTypeAll    MarketType = "all"
+	MarketTypeFees   MarketType = "fees"
+	MarketTypeNoFees MarketType = "no_fees"
+)
+
+type Category string
+
+func (c Category) String() string {
+	return string(c)
+}
+
+const (
+	CategoryAll         Category = "all"
+	CategorySpot        Category = "spot"
+	CategoryDerivatives Category = "derivatives"
+	CategoryDEX         Category = "dex"
+	CategoryLending     Category = "lending"
+)
+
+type listingsLatestOptions struct {
+	Start      int
+	Limit      int
+	Sort       ListingSortField
+	SortDir    types.SortDir
+	MarketType MarketType
+	Category   Category
+	Aux        []string
+	Convert    []currency.Currency
+}
+
+// ListingsLatestOption listings latest optional param.
+type ListingsLatestOption func(opts *listingsLatestOptions)
+
+// WithLLStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithLLStart(start int) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Start = start
+	}
+}
+
+// WithLLLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+// Default 100.
+func WithLLLimit(limit int) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithLLSort what field to sort the list of exchanges by.
+// Default "volume_24h".
+func WithLLSort(field ListingSortField) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Sort = field
+	}
+}
+
+// WithLLSortDir the direction in which to order exchanges against the specified sort.
+func WithLLSortDir(dir types.SortDir) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.SortDir = dir
+	}
+}
+
+// WithLLMarketType the type of exchange markets to include in rankings.
+// Default "all".
+func WithLLMarketType(marketType MarketType) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.MarketType = marketType
+	}
+}
+
+// WithLLCategory the category for this exchange.
+// Default "all".
+func WithLLCategory(category Category) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Category = category
+	}
+}
+
+// WithLLAux specify a list of supplemental data fields to return.
+// By default "num_market_pairs,traffic_score,rank,exchange_score,effective_liquidity_24h".
+func WithLLAux(fields ...string) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Aux = fields
+	}
+}
+
+// WithLLConvert calculate market quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithLLConvert(currencies ...currency.Currency) ListingsLatestOption {
+	return func(opts *listingsLatestOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// ListingsLatest returns a paginated list of all cryptocurrency exchanges including the latest aggregate market data.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ExchangeListingsLatest
+func (e *Exchange) ListingsLatest(
+	ctx context.Context,
+	withOpts ...ListingsLatestOption,
+) (*ListingsLatestResponse, error) {
+	var (
+		options = listingsLatestOptions{
+			Start: 1,
+		}
+		response ListingsLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := e.executor.Get(
+		ctx,
+		listingsLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeListingsLatestQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeListingsLatestQuery(options listingsLatestOptions) string {
+	query := make(url.Values)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	if options.Sort != "" {
+		query.Add("sort", options.Sort.String())
+	}
+
+	if options.SortDir != "" {
+		query.Add("sort_dir", options.SortDir.String())
+	}
+
+	if options.MarketType != "" {
+		query.Add("market_type", options.MarketType.String())
+	}
+
+	if options.Category != "" {
+		query.Add("category", options.Category.String())
+	}
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	return query.Encode()
+}
@@ -0,0 +1,203 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	mapEndpoint = "/v1/exchange/map"
+)
+
+type MapResponse struct {
+	Data   []MapData    `json:"data"`
+	Status types.Status `json:"status"`
+}
+
+// GetStatus returns response status.
+func (m *MapResponse) GetStatus() types.Status {
+	return m.Status
+}
+
+type MapData struct {
+	ID                  int       `json:"id"`
+	Name                string    `json:"name"`
+	Slug                string    `json:"slug"`
+	IsActive            int       `json:"is_active"`
+	Status              string    `json:"status"`
+	FirstHistoricalData time.Time `json:"first_historical_data"`
+	LastHistoricalData  time.Time `json:"last_historical_data"`
+}
+
+type MapStatus string
+
+func (m MapStatus) String() string {
+	return string(m)
+}
+
+const (
+	MapStatusActive    MapStatus = "active"
+	MapStatusInactive  MapStatus = "inactive"
+	MapStatusUntracked MapStatus = "untracked"
+)
+
+type MapSortField string
+
+func (m MapSortField) String() string {
+	return string(m)
+}
+
+const (
+	MapSortID        MapSortField = "id"
+	MapSortVolume24h MapSortField = "volume_24h"
+)
+
+type mapOptions struct {
+	ListingStatus MapStatus
+	Slug          []string
+	Start         int
+	Limit         int
+	Sort          MapSortField
+	Aux           []string
+	CryptoID      int
+}
+
+// MapOption map optional param.
+type MapOption func(opts *mapOptions)
+
+// WithMapListingStatus only active exchanges are returned by default.
+// Default "active".
+func WithMapListingStatus(status MapStatus) MapOption {
+	return func(opts *mapOptions) {
+		opts.ListingStatus = status
+	}
+}
+
+// WithMapSlug list of exchange slugs to return CoinMarketCap IDs for.
+// If this option is passed, other options will be ignored.
+func WithMapSlug(slugs ...string) MapOption {
+	return func(opts *mapOptions) {
+		opts.Slug = slugs
+	}
+}
+
+// WithMapStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithMapStart(start int) MapOption {
+	return func(opts *mapOptions) {
+		opts.Start = start
+	}
+}
+
+// WithMapLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+func WithMapLimit(limit int) MapOption {
+	return func(opts *mapOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithMapSort what field to sort the list of exchanges by.
+// Default "id".
+func WithMapSort(field MapSortField) MapOption {
+	return func(opts *mapOptions) {
+		opts.Sort = field
+	}
+}
+
+// WithMapAux specify a list of supplemental data fields to return.
+// By default "first_historical_data,last_historical_data,is_active".
+func WithMapAux(fields ...string) MapOption {
+	return func(opts *mapOptions) {
+		opts.Aux = fields
+	}
+}
+
+// WithMapCryptoID optionally include one fiat or cryptocurrency id
+// to filter market pairs by.
+func WithMapCryptoID(id int) MapOption {
+	return func(opts *mapOptions) {
+		opts.CryptoID = id
+	}
+}
+
+// Map returns a paginated list of all active cryptocurrency exchanges by CoinMarketCap id.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ExchangeMap
+func (e *Exchange) Map(
+	ctx context.Context,
+	withOpts ...MapOption,
+) (*MapResponse, error) {
+	var (
+		options = mapOptions{
+			ListingStatus: MapStatusActive,
+			Start:         1,
+			Sort:          MapSortID,
+		}
+		response MapResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := e.executor.Get(
+		ctx,
+		mapEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeMapQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeMapQuery(options mapOptions) string {
+	query := make(url.Values)
+
+	if options.ListingStatus != "" {
+		query.Add("listing_status", options.ListingStatus.String())
+	}
+
+	if len(options.Slug) > 0 {
+		query.Add("slug", urlquery.CommaSeparated(options.Slug))
+	}
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	if options.Sort != "" {
+		query.Add("sort", options.Sort.String())
+	}
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	if options.CryptoID > 0 {
+		query.Add("crypto_id", strconv.Itoa(options.CryptoID))
+	}
+
+	return query.Encode()
+}
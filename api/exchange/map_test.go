@@ -0,0 +1,84 @@
+package exchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/exchange"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	mapResponseBody = `
+{
+	"data": [
+		{
+			"id": 270,
+			"name": "Binance",
+			"slug": "binance",
+			"is_active": 1,
+			"status": "active",
+			"first_historical_data": "2018-04-26T00:45:00.000Z",
+			"last_historical_data": "2025-06-28T16:15:00.000Z"
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = exchange.NewMockExecutor(ctrl)
+		exch         = exchange.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/exchange/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"aux=first_historical_data%2Cstatus&crypto_id=1&limit=10&listing_status=inactive"+
+					"&slug=binance%2Ckraken&sort=volume_24h&start=11",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(mapResponseBody), result)
+		})
+
+	exchanges, err := exch.Map(
+		t.Context(),
+		exchange.WithMapListingStatus(exchange.MapStatusInactive),
+		exchange.WithMapSlug("binance", "kraken"),
+		exchange.WithMapStart(11),
+		exchange.WithMapLimit(10),
+		exchange.WithMapSort(exchange.MapSortVolume24h),
+		exchange.WithMapAux("first_historical_data", "status"),
+		exchange.WithMapCryptoID(1),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, exchanges.Data, 1)
+	require.Equal(t, "binance", exchanges.Data[0].Slug)
+	require.Equal(t, time.Date(2018, time.April, 26, 0, 45, 0, 0, time.UTC), exchanges.Data[0].FirstHistoricalData)
+}
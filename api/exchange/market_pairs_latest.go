@@ -0,0 +1,241 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	marketPairsLatestEndpoint = "/v1/exchange/market-pairs/latest"
+)
+
+type MarketPairsLatestResponse struct {
+	Data   MarketPairsData `json:"data"`
+	Status types.Status    `json:"status"`
+}
+
+// GetStatus returns response status.
+func (m *MarketPairsLatestResponse) GetStatus() types.Status {
+	return m.Status
+}
+
+type MarketPairsData struct {
+	ID             int          `json:"id"`
+	Name           string       `json:"name"`
+	Slug           string       `json:"slug"`
+	NumMarketPairs int          `json:"num_market_pairs"`
+	Volume24h      float64      `json:"volume_24h"`
+	MarketPairs    []MarketPair `json:"market_pairs"`
+}
+
+type MarketPair struct {
+	MarketID        int                        `json:"market_id"`
+	MarketPair      string                     `json:"market_pair"`
+	Category        string                     `json:"category"`
+	FeeType         string                     `json:"fee_type"`
+	MarketURL       string                     `json:"market_url"`
+	OutlierDetected int                        `json:"outlier_detected"`
+	Base            MarketPairCurrency         `json:"market_pair_base"`
+	Quote           MarketPairCurrency         `json:"market_pair_quote"`
+	Quotes          map[string]MarketPairQuote `json:"quote"`
+}
+
+type MarketPairCurrency struct {
+	CurrencyID     int    `json:"currency_id"`
+	CurrencySymbol string `json:"currency_symbol"`
+	ExchangeSymbol string `json:"exchange_symbol"`
+	CurrencyType   string `json:"currency_type"`
+}
+
+// MarketPairQuote market pair quote.
+// "exchange_reported" quote contains base and quote volumes, converted quotes contain depth values.
+type MarketPairQuote struct {
+	Price            float64   `json:"price"`
+	Volume24h        float64   `json:"volume_24h"`
+	Volume24hBase    float64   `json:"volume_24h_base"`
+	Volume24hQuote   float64   `json:"volume_24h_quote"`
+	DepthNegativeTwo float64   `json:"depth_negative_two"`
+	DepthPositiveTwo float64   `json:"depth_positive_two"`
+	LastUpdated      time.Time `json:"last_updated"`
+}
+
+type MarketPairCategory string
+
+func (m MarketPairCategory) String() string {
+	return string(m)
+}
+
+const (
+	MarketPairCategoryAll         MarketPairCategory = "all"
+	MarketPairCategorySpot        MarketPairCategory = "spot"
+	MarketPairCategoryDerivatives MarketPairCategory = "derivatives"
+	MarketPairCategoryOTC         MarketPairCategory = "otc"
+	MarketPairCategoryFutures     MarketPairCategory = "futures"
+	MarketPairCategoryPerpetual   MarketPairCategory = "perpetual"
+)
+
+type MarketPairFeeType string
+
+func (m MarketPairFeeType) String() string {
+	return string(m)
+}
+
+const (
+	MarketPairFeeTypeAll                 MarketPairFeeType = "all"
+	MarketPairFeeTypePercentage          MarketPairFeeType = "percentage"
+	MarketPairFeeTypeNoFees              MarketPairFeeType = "no-fees"
+	MarketPairFeeTypeTransactionalMining MarketPairFeeType = "transactional-mining"
+	MarketPairFeeTypeUnknown             MarketPairFeeType = "unknown"
+)
+
+type marketPairsLatestOptions struct {
+	Start    int
+	Limit    int
+	Aux      []string
+	Matched  []currency.Currency
+	Category MarketPairCategory
+	FeeType  MarketPairFeeType
+	Convert  []currency.Currency
+}
+
+// MarketPairsLatestOption market pairs latest optional param.
+type MarketPairsLatestOption func(opts *marketPairsLatestOptions)
+
+// WithMPStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithMPStart(start int) MarketPairsLatestOption {
+	return func(opts *marketPairsLatestOptions) {
+		opts.Start = start
+	}
+}
+
+// WithMPLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+// Default 100.
+func WithMPLimit(limit int) MarketPairsLatestOption {
+	return func(opts *marketPairsLatestOptions) {
+		opts.Limit = limit
+	}
+}
+
+// WithMPAux specify a list of supplemental data fields to return.
+// By default "num_market_pairs,category,fee_type".
+func WithMPAux(fields ...string) MarketPairsLatestOption {
+	return func(opts *marketPairsLatestOptions) {
+		opts.Aux = fields
+	}
+}
+
+// WithMPMatched optionally include one or more currencies to filter market pairs by.
+// Currencies should be specified either all by id or all by symbol.
+func WithMPMatched(currencies ...currency.Currency) MarketPairsLatestOption {
+	return func(opts *marketPairsLatestOptions) {
+		opts.Matched = currencies
+	}
+}
+
+// WithMPCategory the category of trading this market falls under.
+// Default "all".
+func WithMPCategory(category MarketPairCategory) MarketPairsLatestOption {
+	return func(opts *marketPairsLatestOptions) {
+		opts.Category = category
+	}
+}
+
+// WithMPFeeType the fee type the exchange enforces for this market.
+// Default "all".
+func WithMPFeeType(feeType MarketPairFeeType) MarketPairsLatestOption {
+	return func(opts *marketPairsLatestOptions) {
+		opts.FeeType = feeType
+	}
+}
+
+// WithMPConvert calculate market quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithMPConvert(currencies ...currency.Currency) MarketPairsLatestOption {
+	return func(opts *marketPairsLatestOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// MarketPairsLatest returns all active market pairs that CoinMarketCap tracks for a given exchange.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ExchangeMarketpairsLatest
+func (e *Exchange) MarketPairsLatest(
+	ctx context.Context,
+	exchange Identifier,
+	withOpts ...MarketPairsLatestOption,
+) (*MarketPairsLatestResponse, error) {
+	var (
+		options = marketPairsLatestOptions{
+			Start: 1,
+		}
+		response MarketPairsLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := e.executor.Get(
+		ctx,
+		marketPairsLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeMarketPairsLatestQuery(exchange, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeMarketPairsLatestQuery(
+	exchange Identifier,
+	options marketPairsLatestOptions,
+) string {
+	query := make(url.Values)
+	addIdentifiersQuery(query, []Identifier{exchange})
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	urlquery.AddMatched(query, options.Matched)
+
+	if options.Category != "" {
+		query.Add("category", options.Category.String())
+	}
+
+	if options.FeeType != "" {
+		query.Add("fee_type", options.FeeType.String())
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	return query.Encode()
+}
@@ -0,0 +1,112 @@
+package exchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/exchange"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	marketPairsLatestResponseBody = `
+{
+	"data": {
+		"id": 270,
+		"name": "Binance",
+		"slug": "binance",
+		"num_market_pairs": 1800,
+		"volume_24h": 12104281123.5,
+		"market_pairs": [
+			{
+				"market_id": 9933,
+				"market_pair": "BTC/USDT",
+				"category": "spot",
+				"fee_type": "percentage",
+				"market_pair_base": {
+					"currency_id": 1,
+					"currency_symbol": "BTC",
+					"exchange_symbol": "BTC",
+					"currency_type": "cryptocurrency"
+				},
+				"market_pair_quote": {
+					"currency_id": 825,
+					"currency_symbol": "USDT",
+					"exchange_symbol": "USDT",
+					"currency_type": "cryptocurrency"
+				},
+				"quote": {
+					"exchange_reported": {
+						"price": 107290.01,
+						"volume_24h_base": 11250.5,
+						"volume_24h_quote": 1207055310.2,
+						"last_updated": "2025-06-28T16:18:00.000Z"
+					}
+				}
+			}
+		]
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestMarketPairsLatest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = exchange.NewMockExecutor(ctrl)
+		exch         = exchange.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/exchange/market-pairs/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"aux=num_market_pairs&category=spot&convert=EUR&fee_type=percentage&limit=5"+
+					"&matched_symbol=USDT&slug=binance&start=1",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(marketPairsLatestResponseBody), result)
+		})
+
+	pairs, err := exch.MarketPairsLatest(
+		t.Context(),
+		exchange.Slug("binance"),
+		exchange.WithMPLimit(5),
+		exchange.WithMPAux("num_market_pairs"),
+		exchange.WithMPMatched(currency.Symbol("USDT")),
+		exchange.WithMPCategory(exchange.MarketPairCategorySpot),
+		exchange.WithMPFeeType(exchange.MarketPairFeeTypePercentage),
+		exchange.WithMPConvert(currency.Symbol("EUR")),
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, 1800, pairs.Data.NumMarketPairs)
+	require.Len(t, pairs.Data.MarketPairs, 1)
+
+	pair := pairs.Data.MarketPairs[0]
+	require.Equal(t, "BTC/USDT", pair.MarketPair)
+	require.Equal(t, 825, pair.Quote.CurrencyID)
+	require.InDelta(t, 11250.5, pair.Quotes["exchange_reported"].Volume24hBase, 0)
+}
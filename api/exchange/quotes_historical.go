@@ -0,0 +1,153 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	quotesHistoricalEndpoint = "/v1/exchange/quotes/historical"
+)
+
+type QuotesHistoricalResponse struct {
+	Data   QuotesHistoricalData `json:"data"`
+	Status types.Status         `json:"status"`
+}
+
+// GetStatus returns response status.
+func (q *QuotesHistoricalResponse) GetStatus() types.Status {
+	return q.Status
+}
+
+type QuotesHistoricalData struct {
+	ID     int                     `json:"id"`
+	Name   string                  `json:"name"`
+	Slug   string                  `json:"slug"`
+	Quotes []QuotesHistoricalPoint `json:"quotes"`
+}
+
+type QuotesHistoricalPoint struct {
+	Timestamp      time.Time        `json:"timestamp"`
+	NumMarketPairs int              `json:"num_market_pairs"`
+	Quotes         map[string]Quote `json:"quote"`
+}
+
+type quotesHistoricalOptions struct {
+	TimeStart time.Time
+	TimeEnd   time.Time
+	Count     int
+	Interval  types.Interval
+	Convert   []currency.Currency
+}
+
+// QuotesHistoricalOption quotes historical optional param.
+type QuotesHistoricalOption func(opts *quotesHistoricalOptions)
+
+// WithQHTimeStart timestamp to start returning quotes for.
+// Optional, if not passed, we'll return quotes calculated in reverse from "time_end".
+func WithQHTimeStart(start time.Time) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.TimeStart = start
+	}
+}
+
+// WithQHTimeEnd timestamp to stop returning quotes for (inclusive).
+// Optional, if not passed, we'll default to the current time.
+func WithQHTimeEnd(end time.Time) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.TimeEnd = end
+	}
+}
+
+// WithQHCount the number of interval periods to return results for.
+// Default 10.
+func WithQHCount(count int) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Count = count
+	}
+}
+
+// WithQHInterval interval of time to return data points for.
+// Default "5m".
+func WithQHInterval(interval types.Interval) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Interval = interval
+	}
+}
+
+// WithQHConvert calculate quotes in up to 3 other currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithQHConvert(currencies ...currency.Currency) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// QuotesHistorical returns an interval of historic quotes for an exchange based on interval parameters.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ExchangeQuotesHistorical
+func (e *Exchange) QuotesHistorical(
+	ctx context.Context,
+	exchange Identifier,
+	withOpts ...QuotesHistoricalOption,
+) (*QuotesHistoricalResponse, error) {
+	var (
+		options  quotesHistoricalOptions
+		response QuotesHistoricalResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := e.executor.Get(
+		ctx,
+		quotesHistoricalEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeQuotesHistoricalQuery(exchange, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeQuotesHistoricalQuery(
+	exchange Identifier,
+	options quotesHistoricalOptions,
+) string {
+	query := make(url.Values)
+	addIdentifiersQuery(query, []Identifier{exchange})
+
+	urlquery.AddTime(query, "time_start", options.TimeStart)
+	urlquery.AddTime(query, "time_end", options.TimeEnd)
+
+	if options.Count > 0 {
+		query.Add("count", strconv.Itoa(options.Count))
+	}
+
+	if options.Interval != "" {
+		query.Add("interval", options.Interval.String())
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	return query.Encode()
+}
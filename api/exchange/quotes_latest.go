@@ -0,0 +1,119 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	quotesLatestEndpoint = "/v1/exchange/quotes/latest"
+)
+
+type QuotesLatestResponse struct {
+	Data   map[string]QuoteLatestData `json:"data"`
+	Status types.Status               `json:"status"`
+}
+
+// GetStatus returns response status.
+func (q *QuotesLatestResponse) GetStatus() types.Status {
+	return q.Status
+}
+
+type QuoteLatestData struct {
+	ID             int              `json:"id"`
+	Name           string           `json:"name"`
+	Slug           string           `json:"slug"`
+	NumCoins       int              `json:"num_coins"`
+	NumMarketPairs int              `json:"num_market_pairs"`
+	TrafficScore   float64          `json:"traffic_score"`
+	Rank           int              `json:"rank"`
+	ExchangeScore  float64          `json:"exchange_score"`
+	LiquidityScore float64          `json:"liquidity_score"`
+	LastUpdated    time.Time        `json:"last_updated"`
+	Quotes         map[string]Quote `json:"quote"`
+}
+
+type quotesLatestOptions struct {
+	Aux     []string
+	Convert []currency.Currency
+}
+
+// QuotesLatestOption quotes latest optional param.
+type QuotesLatestOption func(opts *quotesLatestOptions)
+
+// WithQLAux specify a list of supplemental data fields to return.
+// By default "num_market_pairs,traffic_score,rank,exchange_score,liquidity_score,effective_liquidity_24h".
+func WithQLAux(fields ...string) QuotesLatestOption {
+	return func(opts *quotesLatestOptions) {
+		opts.Aux = fields
+	}
+}
+
+// WithQLConvert calculate market quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithQLConvert(currencies ...currency.Currency) QuotesLatestOption {
+	return func(opts *quotesLatestOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// QuotesLatest returns the latest aggregate market data for 1 or more exchanges.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1ExchangeQuotesLatest
+func (e *Exchange) QuotesLatest(
+	ctx context.Context,
+	exchanges []Identifier,
+	withOpts ...QuotesLatestOption,
+) (*QuotesLatestResponse, error) {
+	var (
+		options  quotesLatestOptions
+		response QuotesLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := e.executor.Get(
+		ctx,
+		quotesLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeQuotesLatestQuery(exchanges, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeQuotesLatestQuery(
+	exchanges []Identifier,
+	options quotesLatestOptions,
+) string {
+	query := make(url.Values)
+	addIdentifiersQuery(query, exchanges)
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	return query.Encode()
+}
@@ -0,0 +1,71 @@
+package exchange_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/exchange"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+func TestQuotesLatestExecutorError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = exchange.NewMockExecutor(ctrl)
+		exch         = exchange.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/exchange/quotes/latest", gomock.Any(), gomock.Any()).
+		Return(errors.New("some executor error"))
+
+	quotesRsp, err := exch.QuotesLatest(
+		t.Context(),
+		[]exchange.Identifier{exchange.ID(270)},
+	)
+
+	require.Nil(t, quotesRsp)
+	require.EqualError(t, err, "execute get request: some executor error")
+}
+
+func TestQuotesLatestQuery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = exchange.NewMockExecutor(ctrl)
+		exch         = exchange.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/exchange/quotes/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			require.NoError(t, preProcessFn(req))
+
+			require.Equal(t, "convert=EUR&slug=binance%2Ccoinbase-exchange", req.URL.RawQuery)
+
+			return nil
+		})
+
+	_, err := exch.QuotesLatest(
+		t.Context(),
+		[]exchange.Identifier{exchange.Slug("binance"), exchange.Slug("coinbase-exchange")},
+		exchange.WithQLConvert(currency.Symbol("EUR")),
+	)
+
+	require.NoError(t, err)
+}
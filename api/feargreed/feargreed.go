@@ -0,0 +1,39 @@
+package feargreed
+
+import (
+	"context"
+	"net/http"
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+type FearGreed struct {
+	executor Executor
+}
+
+func New(executor Executor) *FearGreed {
+	return &FearGreed{
+		executor: executor,
+	}
+}
+
+type Classification string
+
+func (c Classification) String() string {
+	return string(c)
+}
+
+const (
+	ClassificationExtremeFear  Classification = "Extreme fear"
+	ClassificationFear         Classification = "Fear"
+	ClassificationNeutral      Classification = "Neutral"
+	ClassificationGreed        Classification = "Greed"
+	ClassificationExtremeGreed Classification = "Extreme greed"
+)
@@ -0,0 +1,139 @@
+package feargreed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+const (
+	historicalEndpoint = "/v3/fear-and-greed/historical"
+)
+
+type HistoricalResponse struct {
+	Data   []HistoricalData `json:"data"`
+	Status types.Status     `json:"status"`
+}
+
+// GetStatus returns response status.
+func (h *HistoricalResponse) GetStatus() types.Status {
+	return h.Status
+}
+
+type HistoricalData struct {
+	Timestamp      time.Time
+	Value          int
+	Classification Classification
+}
+
+func (h *HistoricalData) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Timestamp      string         `json:"timestamp"`
+		Value          int            `json:"value"`
+		Classification Classification `json:"value_classification"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("unmarshal historical data: %w", err)
+	}
+
+	seconds, err := strconv.ParseInt(raw.Timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse timestamp: %w", err)
+	}
+
+	h.Timestamp = time.Unix(seconds, 0).UTC()
+	h.Value = raw.Value
+	h.Classification = raw.Classification
+
+	return nil
+}
+
+type historicalOptions struct {
+	Start int
+	Limit int
+}
+
+// HistoricalOption historical optional param.
+type HistoricalOption func(opts *historicalOptions)
+
+// WithHistoricalStart offset the start (1-based index) of the paginated list of items to return.
+// Default 1.
+func WithHistoricalStart(start int) HistoricalOption {
+	return func(opts *historicalOptions) {
+		opts.Start = start
+	}
+}
+
+// WithHistoricalLimit specify the number of results to return.
+// Use this parameter and the "start" parameter to determine your own pagination size.
+// Default 50.
+func WithHistoricalLimit(limit int) HistoricalOption {
+	return func(opts *historicalOptions) {
+		opts.Limit = limit
+	}
+}
+
+// Historical returns a paginated list of all CMC Crypto Fear and Greed values at 12am UTC time.
+// Values are returned in chronological order.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV3FearandgreedHistorical
+func (f *FearGreed) Historical(
+	ctx context.Context,
+	withOpts ...HistoricalOption,
+) (*HistoricalResponse, error) {
+	var (
+		options = historicalOptions{
+			Start: 1,
+		}
+		response HistoricalResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := f.executor.Get(
+		ctx,
+		historicalEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeHistoricalQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	slices.SortFunc(response.Data, func(a, b HistoricalData) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+
+	return &response, nil
+}
+
+func makeHistoricalQuery(options historicalOptions) string {
+	query := make(url.Values)
+
+	if options.Start > 0 {
+		query.Add("start", strconv.Itoa(options.Start))
+	}
+
+	if options.Limit > 0 {
+		query.Add("limit", strconv.Itoa(options.Limit))
+	}
+
+	return query.Encode()
+}
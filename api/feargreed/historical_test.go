@@ -0,0 +1,84 @@
+package feargreed_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/feargreed"
+)
+
+const (
+	historicalResponseBody = `
+{
+	"data": [
+		{"timestamp": "1726704000", "value": 38, "value_classification": "Fear"},
+		{"timestamp": "1726617600", "value": 34, "value_classification": "Fear"},
+		{"timestamp": "1726531200", "value": 55, "value_classification": "Neutral"}
+	],
+	"status": {
+		"timestamp": "2024-09-19T09:20:08.497Z",
+		"error_code": "0",
+		"error_message": "SUCCESS",
+		"elapsed": "2",
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestHistoricalChronologicalOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = feargreed.NewMockExecutor(ctrl)
+		fg           = feargreed.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v3/fear-and-greed/historical", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+			require.NoError(t, err)
+			require.NoError(t, preProcessFn(req))
+			require.Equal(t, "limit=3&start=1", req.URL.RawQuery)
+
+			return json.Unmarshal([]byte(historicalResponseBody), result)
+		})
+
+	historical, err := fg.Historical(t.Context(), feargreed.WithHistoricalLimit(3))
+
+	require.NoError(t, err)
+	require.Equal(t,
+		[]feargreed.HistoricalData{
+			{
+				Timestamp:      time.Date(2024, time.September, 17, 0, 0, 0, 0, time.UTC),
+				Value:          55,
+				Classification: feargreed.ClassificationNeutral,
+			},
+			{
+				Timestamp:      time.Date(2024, time.September, 18, 0, 0, 0, 0, time.UTC),
+				Value:          34,
+				Classification: feargreed.ClassificationFear,
+			},
+			{
+				Timestamp:      time.Date(2024, time.September, 19, 0, 0, 0, 0, time.UTC),
+				Value:          38,
+				Classification: feargreed.ClassificationFear,
+			},
+		},
+		historical.Data,
+	)
+}
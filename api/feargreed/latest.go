@@ -0,0 +1,54 @@
+package feargreed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+const (
+	latestEndpoint = "/v3/fear-and-greed/latest"
+)
+
+type LatestResponse struct {
+	Data   LatestData   `json:"data"`
+	Status types.Status `json:"status"`
+}
+
+// GetStatus returns response status.
+func (l *LatestResponse) GetStatus() types.Status {
+	return l.Status
+}
+
+type LatestData struct {
+	Value          int            `json:"value"`
+	Classification Classification `json:"value_classification"`
+	UpdateTime     time.Time      `json:"update_time"`
+}
+
+// Latest returns the latest CMC Crypto Fear and Greed value.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV3FearandgreedLatest
+func (f *FearGreed) Latest(ctx context.Context) (*LatestResponse, error) {
+	var response LatestResponse
+
+	if err := f.executor.Get(
+		ctx,
+		latestEndpoint,
+		func(req *http.Request) error {
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,103 @@
+package feargreed_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/feargreed"
+)
+
+const (
+	latestResponseBody = `
+{
+	"data": {
+		"value": 52,
+		"update_time": "2025-06-28T16:00:00.000Z",
+		"value_classification": "Neutral"
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": "0",
+		"error_message": "SUCCESS",
+		"elapsed": "1",
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+
+	latestErrorResponseBody = `
+{
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": "1002",
+		"error_message": "API key missing.",
+		"elapsed": "0",
+		"credit_count": 0
+	}
+}
+`
+)
+
+func TestLatest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = feargreed.NewMockExecutor(ctrl)
+		fg           = feargreed.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v3/fear-and-greed/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			return json.Unmarshal([]byte(latestResponseBody), result)
+		})
+
+	latest, err := fg.Latest(t.Context())
+
+	require.NoError(t, err)
+	require.Equal(t, 52, latest.Data.Value)
+	require.Equal(t, feargreed.ClassificationNeutral, latest.Data.Classification)
+	require.Equal(t, time.Date(2025, time.June, 28, 16, 0, 0, 0, time.UTC), latest.Data.UpdateTime)
+	require.Equal(t, 1, latest.Status.Elapsed)
+	require.Equal(t, 1, latest.Status.CreditCount)
+}
+
+func TestLatestErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = feargreed.NewMockExecutor(ctrl)
+		fg           = feargreed.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v3/fear-and-greed/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			return json.Unmarshal([]byte(latestErrorResponseBody), result)
+		})
+
+	latest, err := fg.Latest(t.Context())
+
+	require.Nil(t, latest)
+	require.ErrorIs(t, err, coinmarketcap.ErrInvalidAPIKey)
+}
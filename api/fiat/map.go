@@ -9,6 +9,7 @@ import (
 
 	"github.com/Mikhalevich/coinmarketcap"
 	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
 )
 
 const (
@@ -20,17 +21,40 @@ type MapResponse struct {
 	Status types.Status `json:"status"`
 }
 
+// GetStatus returns response status.
+func (m *MapResponse) GetStatus() types.Status {
+	return m.Status
+}
+
 type MapData struct {
 	ID     int    `json:"id"`
 	Name   string `json:"name"`
 	Sign   string `json:"sign"`
 	Symbol string `json:"symbol"`
+	// IsMetal reports whether the row is a precious metal by its ISO 4217 code (XAU, XAG, XPT, XPD).
+	IsMetal bool `json:"-"`
+}
+
+// Currency returns fiat as currency identified by id.
+func (m MapData) Currency() currency.Currency {
+	return currency.ID(m.ID)
 }
 
+type MapSortField string
+
+func (m MapSortField) String() string {
+	return string(m)
+}
+
+const (
+	MapSortID   MapSortField = "id"
+	MapSortName MapSortField = "name"
+)
+
 type mapOptions struct {
 	Start         int
 	Limit         int
-	Sort          string
+	Sort          MapSortField
 	IncludeMetals bool
 }
 
@@ -55,7 +79,7 @@ func WithMapLimit(limit int) MapOption {
 
 // WithMapSort what field to sort the list by.
 // Default "id".
-func WithMapSort(field string) MapOption {
+func WithMapSort(field MapSortField) MapOption {
 	return func(opts *mapOptions) {
 		opts.Sort = field
 	}
@@ -103,9 +127,23 @@ func (f *Fiat) Map(
 		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
 	}
 
+	for i := range response.Data {
+		response.Data[i].IsMetal = isMetalSymbol(response.Data[i].Symbol)
+	}
+
 	return &response, nil
 }
 
+// isMetalSymbol reports whether symbol is ISO 4217 precious metal code.
+func isMetalSymbol(symbol string) bool {
+	switch symbol {
+	case "XAU", "XAG", "XPT", "XPD":
+		return true
+	}
+
+	return false
+}
+
 func makeMapQuery(opts mapOptions) string {
 	query := make(url.Values)
 
@@ -118,7 +156,7 @@ func makeMapQuery(opts mapOptions) string {
 	}
 
 	if opts.Sort != "" {
-		query.Add("sort", opts.Sort)
+		query.Add("sort", opts.Sort.String())
 	}
 
 	if opts.IncludeMetals {
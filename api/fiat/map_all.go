@@ -0,0 +1,41 @@
+package fiat
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/Mikhalevich/coinmarketcap/internal/pagination"
+)
+
+// MapAll returns iterator over all fiat mappings requesting map pages on demand.
+// Page size is specified by WithMapLimit, default 5000.
+// Iteration starts from WithMapStart offset and stops on the first error.
+func (f *Fiat) MapAll(
+	ctx context.Context,
+	withOpts ...MapOption,
+) iter.Seq2[MapData, error] {
+	var options mapOptions
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	return pagination.AllWithOptions(
+		ctx,
+		withOpts,
+		options.Start,
+		options.Limit,
+		func(start int, limit int) []MapOption {
+			return []MapOption{WithMapStart(start), WithMapLimit(limit)}
+		},
+		func(ctx context.Context, opts ...MapOption) ([]MapData, error) {
+			rsp, err := f.Map(ctx, opts...)
+			if err != nil {
+				return nil, fmt.Errorf("map: %w", err)
+			}
+
+			return rsp.Data, nil
+		},
+	)
+}
@@ -0,0 +1,62 @@
+package fiat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+// Registry resolves fiat ISO symbols to CoinMarketCap ids.
+type Registry struct {
+	bySymbol map[string]MapData
+}
+
+// NewRegistry create registry from fiat map rows.
+func NewRegistry(rows []MapData) *Registry {
+	bySymbol := make(map[string]MapData, len(rows))
+
+	for _, row := range rows {
+		bySymbol[strings.ToUpper(row.Symbol)] = row
+	}
+
+	return &Registry{
+		bySymbol: bySymbol,
+	}
+}
+
+// Registry requests fiat map and builds registry from it.
+func (f *Fiat) Registry(
+	ctx context.Context,
+	withOpts ...MapOption,
+) (*Registry, error) {
+	rsp, err := f.Map(ctx, withOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("fiat map: %w", err)
+	}
+
+	return NewRegistry(rsp.Data), nil
+}
+
+// Lookup returns fiat map row by ISO symbol, symbol is case insensitive.
+func (r *Registry) Lookup(symbol string) (MapData, bool) {
+	row, ok := r.bySymbol[strings.ToUpper(symbol)]
+
+	return row, ok
+}
+
+// Currency returns fiat currency identified by id for ISO symbol, symbol is case insensitive.
+func (r *Registry) Currency(symbol string) (currency.Currency, bool) {
+	row, ok := r.Lookup(symbol)
+	if !ok {
+		return currency.Currency{}, false
+	}
+
+	return row.Currency(), true
+}
+
+// Len returns the number of fiat currencies in the registry.
+func (r *Registry) Len() int {
+	return len(r.bySymbol)
+}
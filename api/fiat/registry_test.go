@@ -0,0 +1,69 @@
+package fiat_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/fiat"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = fiat.NewMockExecutor(ctrl)
+		f            = fiat.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/fiat/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t, "include_metals=true&sort=name", querytest.RawQuery(t, ctx, preProcessFn))
+
+			rsp, ok := result.(*fiat.MapResponse)
+			require.True(t, ok)
+
+			rsp.Data = []fiat.MapData{
+				{ID: 2781, Name: "United States Dollar", Sign: "$", Symbol: "USD"},
+				{ID: 2790, Name: "Euro", Sign: "€", Symbol: "EUR"},
+				{ID: 3575, Name: "Gold Troy Ounce", Symbol: "XAU"},
+			}
+
+			return nil
+		})
+
+	registry, err := f.Registry(
+		t.Context(),
+		fiat.WithMapSort(fiat.MapSortName),
+		fiat.WithMapMetals(true),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 3, registry.Len())
+
+	usd, ok := registry.Currency("usd")
+	require.True(t, ok)
+	require.Equal(t, currency.ID(2781), usd)
+
+	gold, ok := registry.Lookup("XAU")
+	require.True(t, ok)
+	require.True(t, gold.IsMetal)
+
+	eur, ok := registry.Lookup("EUR")
+	require.True(t, ok)
+	require.False(t, eur.IsMetal)
+
+	_, ok = registry.Currency("XYZ")
+	require.False(t, ok)
+}
@@ -0,0 +1,25 @@
+package globalmetrics
+
+import (
+	"context"
+	"net/http"
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+type GlobalMetrics struct {
+	executor Executor
+}
+
+func New(executor Executor) *GlobalMetrics {
+	return &GlobalMetrics{
+		executor: executor,
+	}
+}
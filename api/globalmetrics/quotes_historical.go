@@ -0,0 +1,164 @@
+package globalmetrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	quotesHistoricalEndpoint = "/v1/global-metrics/quotes/historical"
+)
+
+type QuotesHistoricalResponse struct {
+	Data   QuotesHistoricalData `json:"data"`
+	Status types.Status         `json:"status"`
+}
+
+// GetStatus returns response status.
+func (q *QuotesHistoricalResponse) GetStatus() types.Status {
+	return q.Status
+}
+
+type QuotesHistoricalData struct {
+	Quotes []QuotesHistoricalPoint `json:"quotes"`
+}
+
+type QuotesHistoricalPoint struct {
+	Timestamp              time.Time        `json:"timestamp"`
+	BtcDominance           float64          `json:"btc_dominance"`
+	EthDominance           float64          `json:"eth_dominance"`
+	ActiveCryptocurrencies int              `json:"active_cryptocurrencies"`
+	ActiveExchanges        int              `json:"active_exchanges"`
+	ActiveMarketPairs      int              `json:"active_market_pairs"`
+	Quotes                 map[string]Quote `json:"quote"`
+}
+
+type quotesHistoricalOptions struct {
+	TimeStart time.Time
+	TimeEnd   time.Time
+	Count     int
+	Interval  types.Interval
+	Convert   []currency.Currency
+	Aux       []string
+}
+
+// QuotesHistoricalOption quotes historical optional param.
+type QuotesHistoricalOption func(opts *quotesHistoricalOptions)
+
+// WithQHTimeStart timestamp to start returning quotes for.
+// Optional, if not passed, we'll return quotes calculated in reverse from "time_end".
+func WithQHTimeStart(start time.Time) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.TimeStart = start
+	}
+}
+
+// WithQHTimeEnd timestamp to stop returning quotes for (inclusive).
+// Optional, if not passed, we'll default to the current time.
+func WithQHTimeEnd(end time.Time) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.TimeEnd = end
+	}
+}
+
+// WithQHCount the number of interval periods to return results for.
+// Default 10.
+func WithQHCount(count int) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Count = count
+	}
+}
+
+// WithQHInterval interval of time to return data points for.
+// Default "1d".
+func WithQHInterval(interval types.Interval) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Interval = interval
+	}
+}
+
+// WithQHConvert calculate quotes in up to 3 other currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithQHConvert(currencies ...currency.Currency) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// WithQHAux specify a list of supplemental data fields to return.
+// By default "btc_dominance,eth_dominance,active_cryptocurrencies,active_exchanges,active_market_pairs,
+// total_volume_24h,total_volume_24h_reported,altcoin_market_cap,altcoin_volume_24h,altcoin_volume_24h_reported".
+func WithQHAux(fields ...string) QuotesHistoricalOption {
+	return func(opts *quotesHistoricalOptions) {
+		opts.Aux = fields
+	}
+}
+
+// QuotesHistorical returns an interval of historical global cryptocurrency market metrics
+// based on time and interval parameters.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1GlobalmetricsQuotesHistorical
+func (g *GlobalMetrics) QuotesHistorical(
+	ctx context.Context,
+	withOpts ...QuotesHistoricalOption,
+) (*QuotesHistoricalResponse, error) {
+	var (
+		options  quotesHistoricalOptions
+		response QuotesHistoricalResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := g.executor.Get(
+		ctx,
+		quotesHistoricalEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeQuotesHistoricalQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeQuotesHistoricalQuery(options quotesHistoricalOptions) string {
+	query := make(url.Values)
+
+	urlquery.AddTime(query, "time_start", options.TimeStart)
+	urlquery.AddTime(query, "time_end", options.TimeEnd)
+
+	if options.Count > 0 {
+		query.Add("count", strconv.Itoa(options.Count))
+	}
+
+	if options.Interval != "" {
+		query.Add("interval", options.Interval.String())
+	}
+
+	urlquery.AddConvert(query, options.Convert)
+
+	if len(options.Aux) > 0 {
+		query.Add("aux", urlquery.CommaSeparated(options.Aux))
+	}
+
+	return query.Encode()
+}
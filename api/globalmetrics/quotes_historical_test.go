@@ -0,0 +1,95 @@
+package globalmetrics_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/globalmetrics"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	quotesHistoricalResponseBody = `
+{
+	"data": {
+		"quotes": [
+			{
+				"timestamp": "2025-06-27T00:00:00.000Z",
+				"btc_dominance": 64.7,
+				"eth_dominance": 9.01,
+				"active_cryptocurrencies": 9480,
+				"active_exchanges": 799,
+				"active_market_pairs": 94800,
+				"quote": {
+					"2781": {
+						"total_market_cap": 3290000000000.5,
+						"total_volume_24h": 71000000000.25,
+						"timestamp": "2025-06-27T00:00:00.000Z"
+					}
+				}
+			}
+		]
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestQuotesHistorical(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = globalmetrics.NewMockExecutor(ctrl)
+		metrics      = globalmetrics.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/global-metrics/quotes/historical", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"aux=btc_dominance%2Ceth_dominance&convert_id=2781&count=1&interval=daily"+
+					"&time_end=2025-06-28T00%3A00%3A00Z&time_start=2025-06-27T00%3A00%3A00Z",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(quotesHistoricalResponseBody), result)
+		})
+
+	quotes, err := metrics.QuotesHistorical(
+		t.Context(),
+		globalmetrics.WithQHTimeStart(time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC)),
+		globalmetrics.WithQHTimeEnd(time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC)),
+		globalmetrics.WithQHCount(1),
+		globalmetrics.WithQHInterval(types.IntervalDaily),
+		globalmetrics.WithQHConvert(currency.ID(2781)),
+		globalmetrics.WithQHAux("btc_dominance", "eth_dominance"),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, quotes.Data.Quotes, 1)
+
+	point := quotes.Data.Quotes[0]
+	require.Equal(t, time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC), point.Timestamp)
+	require.InDelta(t, 3290000000000.5, point.Quotes["2781"].TotalMarketCap, 0)
+}
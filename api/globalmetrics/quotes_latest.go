@@ -0,0 +1,140 @@
+package globalmetrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	quotesLatestEndpoint = "/v1/global-metrics/quotes/latest"
+)
+
+type QuotesLatestResponse struct {
+	Data   QuotesLatestData `json:"data"`
+	Status types.Status     `json:"status"`
+}
+
+// GetStatus returns response status.
+func (q *QuotesLatestResponse) GetStatus() types.Status {
+	return q.Status
+}
+
+type QuotesLatestData struct {
+	ActiveCryptocurrencies          int              `json:"active_cryptocurrencies"`
+	TotalCryptocurrencies           int              `json:"total_cryptocurrencies"`
+	ActiveMarketPairs               int              `json:"active_market_pairs"`
+	ActiveExchanges                 int              `json:"active_exchanges"`
+	TotalExchanges                  int              `json:"total_exchanges"`
+	EthDominance                    float64          `json:"eth_dominance"`
+	BtcDominance                    float64          `json:"btc_dominance"`
+	EthDominanceYesterday           float64          `json:"eth_dominance_yesterday"`
+	BtcDominanceYesterday           float64          `json:"btc_dominance_yesterday"`
+	EthDominance24hPercentageChange float64          `json:"eth_dominance_24h_percentage_change"`
+	BtcDominance24hPercentageChange float64          `json:"btc_dominance_24h_percentage_change"`
+	DefiVolume24h                   float64          `json:"defi_volume_24h"`
+	DefiVolume24hReported           float64          `json:"defi_volume_24h_reported"`
+	DefiMarketCap                   float64          `json:"defi_market_cap"`
+	Defi24hPercentageChange         float64          `json:"defi_24h_percentage_change"`
+	StablecoinVolume24h             float64          `json:"stablecoin_volume_24h"`
+	StablecoinVolume24hReported     float64          `json:"stablecoin_volume_24h_reported"`
+	StablecoinMarketCap             float64          `json:"stablecoin_market_cap"`
+	Stablecoin24hPercentageChange   float64          `json:"stablecoin_24h_percentage_change"`
+	DerivativesVolume24h            float64          `json:"derivatives_volume_24h"`
+	DerivativesVolume24hReported    float64          `json:"derivatives_volume_24h_reported"`
+	Derivatives24hPercentageChange  float64          `json:"derivatives_24h_percentage_change"`
+	LastUpdated                     time.Time        `json:"last_updated"`
+	Quotes                          map[string]Quote `json:"quote"`
+}
+
+type Quote struct {
+	TotalMarketCap                          float64   `json:"total_market_cap"`
+	TotalVolume24h                          float64   `json:"total_volume_24h"`
+	TotalVolume24hReported                  float64   `json:"total_volume_24h_reported"`
+	AltcoinVolume24h                        float64   `json:"altcoin_volume_24h"`
+	AltcoinVolume24hReported                float64   `json:"altcoin_volume_24h_reported"`
+	AltcoinMarketCap                        float64   `json:"altcoin_market_cap"`
+	DefiVolume24h                           float64   `json:"defi_volume_24h"`
+	DefiVolume24hReported                   float64   `json:"defi_volume_24h_reported"`
+	Defi24hPercentageChange                 float64   `json:"defi_24h_percentage_change"`
+	DefiMarketCap                           float64   `json:"defi_market_cap"`
+	StablecoinVolume24h                     float64   `json:"stablecoin_volume_24h"`
+	StablecoinVolume24hReported             float64   `json:"stablecoin_volume_24h_reported"`
+	Stablecoin24hPercentageChange           float64   `json:"stablecoin_24h_percentage_change"`
+	StablecoinMarketCap                     float64   `json:"stablecoin_market_cap"`
+	DerivativesVolume24h                    float64   `json:"derivatives_volume_24h"`
+	DerivativesVolume24hReported            float64   `json:"derivatives_volume_24h_reported"`
+	Derivatives24hPercentageChange          float64   `json:"derivatives_24h_percentage_change"`
+	TotalMarketCapYesterday                 float64   `json:"total_market_cap_yesterday"`
+	TotalVolume24hYesterday                 float64   `json:"total_volume_24h_yesterday"`
+	TotalMarketCapYesterdayPercentageChange float64   `json:"total_market_cap_yesterday_percentage_change"`
+	TotalVolume24hYesterdayPercentageChange float64   `json:"total_volume_24h_yesterday_percentage_change"`
+	Timestamp                               time.Time `json:"timestamp"`
+	LastUpdated                             time.Time `json:"last_updated"`
+}
+
+type quotesLatestOptions struct {
+	Convert []currency.Currency
+}
+
+// QuotesLatestOption quotes latest optional param.
+type QuotesLatestOption func(opts *quotesLatestOptions)
+
+// WithQLConvert calculate market quotes in up to 120 currencies at once.
+// Currencies should be specified either all by id or all by symbol.
+// Default "USD".
+func WithQLConvert(currencies ...currency.Currency) QuotesLatestOption {
+	return func(opts *quotesLatestOptions) {
+		opts.Convert = currencies
+	}
+}
+
+// QuotesLatest returns the latest global cryptocurrency market metrics.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV1GlobalmetricsQuotesLatest
+func (g *GlobalMetrics) QuotesLatest(
+	ctx context.Context,
+	withOpts ...QuotesLatestOption,
+) (*QuotesLatestResponse, error) {
+	var (
+		options  quotesLatestOptions
+		response QuotesLatestResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := g.executor.Get(
+		ctx,
+		quotesLatestEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeQuotesLatestQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeQuotesLatestQuery(options quotesLatestOptions) string {
+	query := make(url.Values)
+
+	urlquery.AddConvert(query, options.Convert)
+
+	return query.Encode()
+}
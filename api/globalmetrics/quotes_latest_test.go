@@ -0,0 +1,80 @@
+package globalmetrics_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/globalmetrics"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	quotesLatestResponseBody = `
+{
+	"data": {
+		"active_cryptocurrencies": 9500,
+		"total_cryptocurrencies": 31000,
+		"active_market_pairs": 95000,
+		"active_exchanges": 800,
+		"total_exchanges": 9000,
+		"eth_dominance": 8.95,
+		"btc_dominance": 64.82,
+		"last_updated": "2025-06-28T16:15:00.000Z",
+		"quote": {
+			"EUR": {
+				"total_market_cap": 2841291000000.12,
+				"total_volume_24h": 62010000000.34,
+				"last_updated": "2025-06-28T16:15:00.000Z"
+			}
+		}
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": 0,
+		"error_message": "",
+		"elapsed": 10,
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestQuotesLatest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = globalmetrics.NewMockExecutor(ctrl)
+		metrics      = globalmetrics.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/global-metrics/quotes/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t, "convert=EUR%2CUSD", querytest.RawQuery(t, ctx, preProcessFn))
+
+			return json.Unmarshal([]byte(quotesLatestResponseBody), result)
+		})
+
+	quotes, err := metrics.QuotesLatest(
+		t.Context(),
+		globalmetrics.WithQLConvert(currency.Symbol("EUR"), currency.Symbol("USD")),
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, 9500, quotes.Data.ActiveCryptocurrencies)
+	require.InDelta(t, 64.82, quotes.Data.BtcDominance, 0)
+	require.InDelta(t, 2841291000000.12, quotes.Data.Quotes["EUR"].TotalMarketCap, 0)
+}
@@ -0,0 +1,129 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	cmc100HistoricalEndpoint = "/v3/index/cmc100-historical"
+)
+
+type CMC100HistoricalResponse struct {
+	Data   []CMC100HistoricalData `json:"data"`
+	Status types.Status           `json:"status"`
+}
+
+// GetStatus returns response status.
+func (c *CMC100HistoricalResponse) GetStatus() types.Status {
+	return c.Status
+}
+
+type CMC100HistoricalData struct {
+	Value        float64       `json:"value"`
+	UpdateTime   time.Time     `json:"update_time"`
+	Constituents []Constituent `json:"constituents"`
+}
+
+type cmc100HistoricalOptions struct {
+	TimeStart time.Time
+	TimeEnd   time.Time
+	Count     int
+	Interval  types.Interval
+}
+
+// CMC100HistoricalOption cmc100 historical optional param.
+type CMC100HistoricalOption func(opts *cmc100HistoricalOptions)
+
+// WithCMC100HTimeStart timestamp to start returning index values for.
+// Optional, if not passed, we'll return values calculated in reverse from "time_end".
+func WithCMC100HTimeStart(start time.Time) CMC100HistoricalOption {
+	return func(opts *cmc100HistoricalOptions) {
+		opts.TimeStart = start
+	}
+}
+
+// WithCMC100HTimeEnd timestamp to stop returning index values for (inclusive).
+// Optional, if not passed, we'll default to the current time.
+func WithCMC100HTimeEnd(end time.Time) CMC100HistoricalOption {
+	return func(opts *cmc100HistoricalOptions) {
+		opts.TimeEnd = end
+	}
+}
+
+// WithCMC100HCount the number of interval periods to return results for.
+// Default 10.
+func WithCMC100HCount(count int) CMC100HistoricalOption {
+	return func(opts *cmc100HistoricalOptions) {
+		opts.Count = count
+	}
+}
+
+// WithCMC100HInterval interval of time to return data points for.
+// Supported values are "5m", "15m" and "daily".
+// Default "daily".
+func WithCMC100HInterval(interval types.Interval) CMC100HistoricalOption {
+	return func(opts *cmc100HistoricalOptions) {
+		opts.Interval = interval
+	}
+}
+
+// CMC100Historical returns an interval of historic CoinMarketCap 100 Index values based on the interval parameter.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV3IndexCmc100historical
+func (i *Index) CMC100Historical(
+	ctx context.Context,
+	withOpts ...CMC100HistoricalOption,
+) (*CMC100HistoricalResponse, error) {
+	var (
+		options  cmc100HistoricalOptions
+		response CMC100HistoricalResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := i.executor.Get(
+		ctx,
+		cmc100HistoricalEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makeCMC100HistoricalQuery(options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makeCMC100HistoricalQuery(options cmc100HistoricalOptions) string {
+	query := make(url.Values)
+
+	urlquery.AddTime(query, "time_start", options.TimeStart)
+	urlquery.AddTime(query, "time_end", options.TimeEnd)
+
+	if options.Count > 0 {
+		query.Add("count", strconv.Itoa(options.Count))
+	}
+
+	if options.Interval != "" {
+		query.Add("interval", options.Interval.String())
+	}
+
+	return query.Encode()
+}
@@ -0,0 +1,88 @@
+package index_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/index"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	cmc100HistoricalResponseBody = `
+{
+	"data": [
+		{
+			"value": 212.11,
+			"update_time": "2025-06-27T00:00:00.000Z",
+			"constituents": [
+				{
+					"id": 1027,
+					"name": "Ethereum",
+					"symbol": "ETH",
+					"url": "https://coinmarketcap.com/currencies/ethereum/",
+					"weight": 0.0912
+				}
+			]
+		}
+	],
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": "0",
+		"error_message": "SUCCESS",
+		"elapsed": "5",
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestCMC100Historical(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = index.NewMockExecutor(ctrl)
+		idx          = index.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v3/index/cmc100-historical", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Equal(t,
+				"count=1&interval=daily&time_end=2025-06-28T00%3A00%3A00Z&time_start=2025-06-27T00%3A00%3A00Z",
+				querytest.RawQuery(t, ctx, preProcessFn),
+			)
+
+			return json.Unmarshal([]byte(cmc100HistoricalResponseBody), result)
+		})
+
+	historical, err := idx.CMC100Historical(
+		t.Context(),
+		index.WithCMC100HTimeStart(time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC)),
+		index.WithCMC100HTimeEnd(time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC)),
+		index.WithCMC100HCount(1),
+		index.WithCMC100HInterval(types.IntervalDaily),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, historical.Data, 1)
+
+	point := historical.Data[0]
+	require.InDelta(t, 212.11, point.Value, 0)
+	require.Equal(t, time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC), point.UpdateTime)
+	require.Equal(t, 1027, point.Constituents[0].ID)
+}
@@ -0,0 +1,56 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+const (
+	cmc100LatestEndpoint = "/v3/index/cmc100-latest"
+)
+
+type CMC100LatestResponse struct {
+	Data   CMC100LatestData `json:"data"`
+	Status types.Status     `json:"status"`
+}
+
+// GetStatus returns response status.
+func (c *CMC100LatestResponse) GetStatus() types.Status {
+	return c.Status
+}
+
+type CMC100LatestData struct {
+	Value                    float64       `json:"value"`
+	Value24hPercentageChange float64       `json:"value_24h_percentage_change"`
+	LastUpdate               time.Time     `json:"last_update"`
+	NextUpdate               time.Time     `json:"next_update"`
+	Constituents             []Constituent `json:"constituents"`
+}
+
+// CMC100Latest returns the latest CoinMarketCap 100 Index value, constituents, and constituent weights.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV3IndexCmc100latest
+func (i *Index) CMC100Latest(ctx context.Context) (*CMC100LatestResponse, error) {
+	var response CMC100LatestResponse
+
+	if err := i.executor.Get(
+		ctx,
+		cmc100LatestEndpoint,
+		func(req *http.Request) error {
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
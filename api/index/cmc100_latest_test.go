@@ -0,0 +1,79 @@
+package index_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/index"
+	"github.com/Mikhalevich/coinmarketcap/internal/querytest"
+)
+
+const (
+	cmc100LatestResponseBody = `
+{
+	"data": {
+		"value": 215.43,
+		"value_24h_percentage_change": -1.27,
+		"last_update": "2025-06-28T16:15:00.000Z",
+		"next_update": "2025-06-28T16:20:00.000Z",
+		"constituents": [
+			{
+				"id": 1,
+				"name": "Bitcoin",
+				"symbol": "BTC",
+				"url": "https://coinmarketcap.com/currencies/bitcoin/",
+				"weight": 0.6123
+			}
+		]
+	},
+	"status": {
+		"timestamp": "2025-06-28T16:19:48.947Z",
+		"error_code": "0",
+		"error_message": "SUCCESS",
+		"elapsed": "3",
+		"credit_count": 1,
+		"notice": ""
+	}
+}
+`
+)
+
+func TestCMC100Latest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = index.NewMockExecutor(ctrl)
+		idx          = index.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v3/index/cmc100-latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			require.Empty(t, querytest.RawQuery(t, ctx, preProcessFn))
+
+			return json.Unmarshal([]byte(cmc100LatestResponseBody), result)
+		})
+
+	latest, err := idx.CMC100Latest(t.Context())
+
+	require.NoError(t, err)
+	require.InDelta(t, 215.43, latest.Data.Value, 0)
+	require.InDelta(t, -1.27, latest.Data.Value24hPercentageChange, 0)
+	require.Equal(t, time.Date(2025, time.June, 28, 16, 20, 0, 0, time.UTC), latest.Data.NextUpdate)
+	require.Len(t, latest.Data.Constituents, 1)
+	require.Equal(t, "BTC", latest.Data.Constituents[0].Symbol)
+	require.InDelta(t, 0.6123, latest.Data.Constituents[0].Weight, 0)
+	require.Equal(t, 3, latest.Status.Elapsed)
+}
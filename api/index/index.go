@@ -0,0 +1,33 @@
+package index
+
+import (
+	"context"
+	"net/http"
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+type Index struct {
+	executor Executor
+}
+
+func New(executor Executor) *Index {
+	return &Index{
+		executor: executor,
+	}
+}
+
+type Constituent struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Symbol string  `json:"symbol"`
+	URL    string  `json:"url"`
+	Weight float64 `json:"weight"`
+}
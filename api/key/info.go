@@ -19,6 +19,11 @@ type KeyResponse struct {
 	Status types.Status `json:"status"`
 }
 
+// GetStatus returns response status.
+func (k *KeyResponse) GetStatus() types.Status {
+	return k.Status
+}
+
 type KeyData struct {
 	Plan  KeyPlan  `json:"plan"`
 	Usage KeyUsage `json:"usage"`
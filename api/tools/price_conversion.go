@@ -0,0 +1,158 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+const (
+	priceConversionEndpoint = "/v2/tools/price-conversion"
+)
+
+type PriceConversionResponse struct {
+	Data   PriceConversionList `json:"data"`
+	Status types.Status        `json:"status"`
+}
+
+// GetStatus returns response status.
+func (p *PriceConversionResponse) GetStatus() types.Status {
+	return p.Status
+}
+
+// PriceConversionList conversion results.
+// Api returns single object for conversion by id and list of objects for conversion by symbol,
+// both forms are decoded into the list.
+type PriceConversionList []PriceConversionData
+
+func (p *PriceConversionList) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var single PriceConversionData
+		if err := json.Unmarshal(trimmed, &single); err != nil {
+			return fmt.Errorf("unmarshal single conversion: %w", err)
+		}
+
+		*p = PriceConversionList{single}
+
+		return nil
+	}
+
+	var list []PriceConversionData
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("unmarshal conversion list: %w", err)
+	}
+
+	*p = list
+
+	return nil
+}
+
+type PriceConversionData struct {
+	ID          int                             `json:"id"`
+	Symbol      string                          `json:"symbol"`
+	Name        string                          `json:"name"`
+	Amount      float64                         `json:"amount"`
+	LastUpdated time.Time                       `json:"last_updated"`
+	Quotes      map[string]PriceConversionQuote `json:"quote"`
+}
+
+// PriceConversionQuote converted amount in target currency.
+type PriceConversionQuote struct {
+	Price       float64   `json:"price"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// ConvertedAmounts returns converted amount for each target currency.
+func (p PriceConversionData) ConvertedAmounts() map[string]float64 {
+	if len(p.Quotes) == 0 {
+		return nil
+	}
+
+	amounts := make(map[string]float64, len(p.Quotes))
+
+	for target, quote := range p.Quotes {
+		amounts[target] = quote.Price
+	}
+
+	return amounts
+}
+
+type priceConversionOptions struct {
+	Time time.Time
+}
+
+// PriceConversionOption price conversion optional param.
+type PriceConversionOption func(opts *priceConversionOptions)
+
+// WithPCTime timestamp to reference historical pricing during conversion.
+// If not passed, the current time will be used.
+func WithPCTime(t time.Time) PriceConversionOption {
+	return func(opts *priceConversionOptions) {
+		opts.Time = t
+	}
+}
+
+// PriceConversion convert an amount of one cryptocurrency or fiat currency
+// into one or more different currencies utilizing the latest market rate for each currency.
+// Target currencies should be specified either all by id or all by symbol.
+// https://coinmarketcap.com/api/documentation/v1/#operation/getV2ToolsPriceconversion
+func (t *Tools) PriceConversion(
+	ctx context.Context,
+	amount float64,
+	from currency.Currency,
+	to []currency.Currency,
+	withOpts ...PriceConversionOption,
+) (*PriceConversionResponse, error) {
+	var (
+		options  priceConversionOptions
+		response PriceConversionResponse
+	)
+
+	for _, option := range withOpts {
+		option(&options)
+	}
+
+	if err := t.executor.Get(
+		ctx,
+		priceConversionEndpoint,
+		func(req *http.Request) error {
+			req.URL.RawQuery = makePriceConversionQuery(amount, from, to, options)
+
+			return nil
+		},
+		&response,
+	); err != nil {
+		return nil, fmt.Errorf("execute get request: %w", err)
+	}
+
+	if response.Status.IsError() {
+		return nil, coinmarketcap.NewError(response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	return &response, nil
+}
+
+func makePriceConversionQuery(
+	amount float64,
+	from currency.Currency,
+	to []currency.Currency,
+	options priceConversionOptions,
+) string {
+	query := make(url.Values)
+	query.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+	urlquery.AddCurrencies(query, []currency.Currency{from})
+	urlquery.AddConvert(query, to)
+	urlquery.AddTime(query, "time", options.Time)
+
+	return query.Encode()
+}
@@ -0,0 +1,92 @@
+package tools_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/tools"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+func TestPriceConversion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		from     currency.Currency
+		rawQuery string
+		body     string
+	}{
+		{
+			name:     "by id",
+			from:     currency.ID(1),
+			rawQuery: "amount=1.5&convert=USD%2CEUR&id=1",
+			body: `{
+				"data": {
+					"id": 1, "symbol": "BTC", "name": "Bitcoin", "amount": 1.5,
+					"quote": {"USD": {"price": 150000}, "EUR": {"price": 130000}}
+				},
+				"status": {"error_code": 0}
+			}`,
+		},
+		{
+			name:     "by symbol",
+			from:     currency.Symbol("BTC"),
+			rawQuery: "amount=1.5&convert=USD%2CEUR&symbol=BTC",
+			body: `{
+				"data": [{
+					"id": 1, "symbol": "BTC", "name": "Bitcoin", "amount": 1.5,
+					"quote": {"USD": {"price": 150000}, "EUR": {"price": 130000}}
+				}],
+				"status": {"error_code": 0}
+			}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctrl         = gomock.NewController(t)
+				mockExecutor = tools.NewMockExecutor(ctrl)
+				tls          = tools.New(mockExecutor)
+			)
+
+			mockExecutor.EXPECT().
+				Get(t.Context(), "/v2/tools/price-conversion", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(
+					ctx context.Context,
+					path string,
+					preProcessFn func(req *http.Request) error,
+					result any,
+				) error {
+					req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+					require.NoError(t, err)
+					require.NoError(t, preProcessFn(req))
+					require.Equal(t, test.rawQuery, req.URL.RawQuery)
+
+					return json.Unmarshal([]byte(test.body), result)
+				})
+
+			conversion, err := tls.PriceConversion(
+				t.Context(),
+				1.5,
+				test.from,
+				[]currency.Currency{currency.Symbol("USD"), currency.Symbol("EUR")},
+			)
+
+			require.NoError(t, err)
+			require.Len(t, conversion.Data, 1)
+			require.Equal(t,
+				map[string]float64{"USD": 150000, "EUR": 130000},
+				conversion.Data[0].ConvertedAmounts(),
+			)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+type Tools struct {
+	executor Executor
+}
+
+func New(executor Executor) *Tools {
+	return &Tools{
+		executor: executor,
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -13,10 +16,76 @@ type Status struct {
 	Notice       string    `json:"notice"`
 }
 
+// UnmarshalJSON decodes status with error code and elapsed encoded either as numbers or as strings,
+// v3 endpoints encode them as strings.
+func (s *Status) UnmarshalJSON(data []byte) error {
+	type plainStatus Status
+
+	var raw struct {
+		plainStatus
+
+		ErrorCode json.RawMessage `json:"error_code"`
+		Elapsed   json.RawMessage `json:"elapsed"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("json decode status: %w", err)
+	}
+
+	errorCode, err := decodeFlexibleInt(raw.ErrorCode)
+	if err != nil {
+		return fmt.Errorf("decode error_code: %w", err)
+	}
+
+	elapsed, err := decodeFlexibleInt(raw.Elapsed)
+	if err != nil {
+		return fmt.Errorf("decode elapsed: %w", err)
+	}
+
+	*s = Status(raw.plainStatus)
+	s.ErrorCode = errorCode
+	s.Elapsed = elapsed
+
+	return nil
+}
+
 func (s Status) IsError() bool {
 	return s.ErrorCode != 0
 }
 
+// decodeFlexibleInt decodes integer encoded either as json number or as json string.
+func decodeFlexibleInt(raw json.RawMessage) (int, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0, nil
+	}
+
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		if str == "" {
+			return 0, nil
+		}
+
+		value, err := strconv.Atoi(str)
+		if err != nil {
+			return 0, fmt.Errorf("parse int: %w", err)
+		}
+
+		return value, nil
+	}
+
+	var value int
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return 0, fmt.Errorf("json decode int: %w", err)
+	}
+
+	return value, nil
+}
+
+// StatusProvider response exposing its status.
+type StatusProvider interface {
+	GetStatus() Status
+}
+
 type PlatformV1 struct {
 	ID          int    `json:"id"`
 	Name        string `json:"name"`
@@ -32,3 +101,52 @@ type PlatformV2 struct {
 	Slug        string `json:"slug"`
 	TokenAdress string `json:"token_address"`
 }
+
+// SortDir direction in which to order results.
+type SortDir string
+
+func (s SortDir) String() string {
+	return string(s)
+}
+
+const (
+	SortDirAsc  SortDir = "asc"
+	SortDirDesc SortDir = "desc"
+)
+
+// Interval time interval between returned data points.
+type Interval string
+
+func (i Interval) String() string {
+	return string(i)
+}
+
+const (
+	IntervalYearly  Interval = "yearly"
+	IntervalMonthly Interval = "monthly"
+	IntervalWeekly  Interval = "weekly"
+	IntervalDaily   Interval = "daily"
+	IntervalHourly  Interval = "hourly"
+	Interval5m      Interval = "5m"
+	Interval10m     Interval = "10m"
+	Interval15m     Interval = "15m"
+	Interval30m     Interval = "30m"
+	Interval45m     Interval = "45m"
+	Interval1h      Interval = "1h"
+	Interval2h      Interval = "2h"
+	Interval3h      Interval = "3h"
+	Interval4h      Interval = "4h"
+	Interval6h      Interval = "6h"
+	Interval12h     Interval = "12h"
+	Interval24h     Interval = "24h"
+	Interval1d      Interval = "1d"
+	Interval2d      Interval = "2d"
+	Interval3d      Interval = "3d"
+	Interval7d      Interval = "7d"
+	Interval14d     Interval = "14d"
+	Interval15d     Interval = "15d"
+	Interval30d     Interval = "30d"
+	Interval60d     Interval = "60d"
+	Interval90d     Interval = "90d"
+	Interval365d    Interval = "365d"
+)
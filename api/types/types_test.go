@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+func TestStatusUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		body     string
+		expected types.Status
+	}{
+		{
+			name: "v1 numeric codes",
+			body: `{"timestamp":"2025-06-28T16:19:48.947Z","error_code":1008,"error_message":"rate limit",` +
+				`"elapsed":10,"credit_count":1,"notice":""}`,
+			expected: types.Status{
+				Timestamp:    time.Date(2025, time.June, 28, 16, 19, 48, 947000000, time.UTC),
+				ErrorCode:    1008,
+				ErrorMessage: "rate limit",
+				Elapsed:      10,
+				CreditCount:  1,
+			},
+		},
+		{
+			name: "v3 string codes",
+			body: `{"timestamp":"2025-06-28T16:19:48.947Z","error_code":"0","error_message":"SUCCESS",` +
+				`"elapsed":"2","credit_count":1,"notice":""}`,
+			expected: types.Status{
+				Timestamp:    time.Date(2025, time.June, 28, 16, 19, 48, 947000000, time.UTC),
+				ErrorMessage: "SUCCESS",
+				Elapsed:      2,
+				CreditCount:  1,
+			},
+		},
+		{
+			name:     "missing codes",
+			body:     `{"credit_count":1}`,
+			expected: types.Status{CreditCount: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var status types.Status
+
+			require.NoError(t, json.Unmarshal([]byte(tc.body), &status))
+			require.Equal(t, tc.expected, status)
+		})
+	}
+}
+
+func TestStatusUnmarshalInvalidCode(t *testing.T) {
+	t.Parallel()
+
+	var status types.Status
+
+	require.Error(t, json.Unmarshal([]byte(`{"error_code":"unknown"}`), &status))
+}
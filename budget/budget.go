@@ -0,0 +1,364 @@
+package budget
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/key"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+type Period string
+
+func (p Period) String() string {
+	return string(p)
+}
+
+const (
+	PeriodDaily   Period = "daily"
+	PeriodMonthly Period = "monthly"
+)
+
+// ExhaustedError returned before sending request when credit budget is exhausted.
+type ExhaustedError struct {
+	Period Period
+	Limit  int
+	Used   int
+}
+
+func (e *ExhaustedError) Error() string {
+	return fmt.Sprintf("%s credit budget exhausted: used %d of %d", e.Period, e.Used, e.Limit)
+}
+
+// Is reports exhausted budget as credit limit error.
+func (e *ExhaustedError) Is(target error) bool {
+	return target == coinmarketcap.ErrCreditLimit
+}
+
+type tagKey struct{}
+
+// WithTag returns context with caller tag, credits of requests made with the context are recorded under the tag.
+func WithTag(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, tagKey{}, tag)
+}
+
+// TagFromContext returns caller tag of the context.
+func TagFromContext(ctx context.Context) string {
+	tag, _ := ctx.Value(tagKey{}).(string)
+
+	return tag
+}
+
+// Usage spent credits and number of requests.
+type Usage struct {
+	Requests int
+	Credits  int
+}
+
+// Snapshot credits usage at the moment.
+type Snapshot struct {
+	DailyLimit   int
+	DailyUsed    int
+	MonthlyLimit int
+	MonthlyUsed  int
+	// Endpoints usage per endpoint path since the tracker is created.
+	Endpoints map[string]Usage
+	// Tags usage per caller tag since the tracker is created, untagged requests are recorded under "".
+	Tags map[string]Usage
+}
+
+// Tracker executor decorator recording credits spent by requests and enforcing credit budgets.
+// Safe for concurrent use.
+type Tracker struct {
+	executor Executor
+	clock    coinmarketcap.Clock
+
+	mu           sync.Mutex
+	dailyLimit   int
+	dailyUsed    int
+	dayEnd       time.Time
+	monthlyLimit int
+	monthlyUsed  int
+	monthEnd     time.Time
+	estimate     int
+	reserved     int
+	endpoints    map[string]Usage
+	tags         map[string]Usage
+}
+
+type options struct {
+	DailyLimit   int
+	MonthlyLimit int
+	MonthlyReset time.Time
+	Usage        key.KeyUsage
+	Estimate     int
+	Clock        coinmarketcap.Clock
+}
+
+// Option tracker optional param.
+type Option func(opts *options)
+
+// WithDailyLimit daily credits budget, resets at UTC midnight.
+// Non-positive limit is not enforced.
+func WithDailyLimit(limit int) Option {
+	return func(opts *options) {
+		opts.DailyLimit = limit
+	}
+}
+
+// WithMonthlyLimit monthly credits budget.
+// Non-positive limit is not enforced.
+func WithMonthlyLimit(limit int) Option {
+	return func(opts *options) {
+		opts.MonthlyLimit = limit
+	}
+}
+
+// WithMonthlyReset time of the next monthly budget reset.
+// Default start of the next calendar month in UTC.
+func WithMonthlyReset(reset time.Time) Option {
+	return func(opts *options) {
+		opts.MonthlyReset = reset
+	}
+}
+
+// WithUsage seed already spent daily and monthly credits.
+func WithUsage(usage key.KeyUsage) Option {
+	return func(opts *options) {
+		opts.Usage = usage
+	}
+}
+
+// WithEstimate credits reserved for in flight request until its actual cost is known.
+// Default 1 credit.
+func WithEstimate(credits int) Option {
+	return func(opts *options) {
+		opts.Estimate = credits
+	}
+}
+
+// WithClock time source, could be replaced in tests.
+// Default real time.
+func WithClock(clock coinmarketcap.Clock) Option {
+	return func(opts *options) {
+		opts.Clock = clock
+	}
+}
+
+// New construct credit tracker for executor.
+func New(executor Executor, opts ...Option) *Tracker {
+	options := options{
+		Estimate: 1,
+		Clock:    coinmarketcap.SystemClock{},
+	}
+
+	for _, option := range opts {
+		option(&options)
+	}
+
+	now := options.Clock.Now()
+
+	monthEnd := options.MonthlyReset
+	if monthEnd.IsZero() {
+		monthEnd = nextMonth(now)
+	}
+
+	return &Tracker{
+		executor:     executor,
+		clock:        options.Clock,
+		dailyLimit:   options.DailyLimit,
+		dailyUsed:    int(options.Usage.CurrentDay.CreditsUsed),
+		dayEnd:       nextDay(now),
+		monthlyLimit: options.MonthlyLimit,
+		monthlyUsed:  int(options.Usage.CurrentMonth.CreditsUsed),
+		monthEnd:     monthEnd,
+		estimate:     max(options.Estimate, 0),
+		endpoints:    make(map[string]Usage),
+		tags:         make(map[string]Usage),
+	}
+}
+
+// FromKeyInfo construct credit tracker with budgets and usage of the api key.
+// Monthly limit and reset are taken from the key plan, daily limit is calculated from the current day usage.
+// Options override values taken from the key info.
+func FromKeyInfo(ctx context.Context, executor Executor, opts ...Option) (*Tracker, error) {
+	info, err := key.New(executor).Info(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("key info: %w", err)
+	}
+
+	var (
+		plan  = info.Data.Plan
+		usage = info.Data.Usage
+		day   = usage.CurrentDay
+	)
+
+	keyOpts := []Option{
+		WithMonthlyLimit(int(plan.CreditLimitMonthly)),
+		WithMonthlyReset(plan.CreditLimitMonthlyResetTimestamp),
+		WithUsage(usage),
+	}
+
+	if day.CreditsLeft > 0 {
+		keyOpts = append(keyOpts, WithDailyLimit(int(day.CreditsUsed+day.CreditsLeft)))
+	}
+
+	return New(executor, append(keyOpts, opts...)...), nil
+}
+
+// Get reserves estimated credits, executes request and records credits reported in response status.
+// Response status is taken from result implementing types.StatusProvider.
+func (t *Tracker) Get(
+	ctx context.Context,
+	path string,
+	preProcessFn func(req *http.Request) error,
+	result any,
+) error {
+	if err := t.reserve(); err != nil {
+		return err
+	}
+
+	if err := t.executor.Get(ctx, path, preProcessFn, result); err != nil {
+		t.release()
+
+		return fmt.Errorf("budget: %w", err)
+	}
+
+	status, ok := responseStatus(result)
+	if !ok {
+		t.release()
+
+		return nil
+	}
+
+	t.record(path, TagFromContext(ctx), status.CreditCount)
+
+	return nil
+}
+
+// Snapshot returns current credits usage.
+func (t *Tracker) Snapshot() Snapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.reset(t.clock.Now())
+
+	return Snapshot{
+		DailyLimit:   t.dailyLimit,
+		DailyUsed:    t.dailyUsed,
+		MonthlyLimit: t.monthlyLimit,
+		MonthlyUsed:  t.monthlyUsed,
+		Endpoints:    maps.Clone(t.endpoints),
+		Tags:         maps.Clone(t.tags),
+	}
+}
+
+// reserve checks budgets including credits reserved by in flight requests
+// and reserves estimated credits for the new request.
+func (t *Tracker) reserve() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.reset(t.clock.Now())
+
+	if t.dailyLimit > 0 && t.dailyUsed+t.reserved >= t.dailyLimit {
+		return &ExhaustedError{
+			Period: PeriodDaily,
+			Limit:  t.dailyLimit,
+			Used:   t.dailyUsed + t.reserved,
+		}
+	}
+
+	if t.monthlyLimit > 0 && t.monthlyUsed+t.reserved >= t.monthlyLimit {
+		return &ExhaustedError{
+			Period: PeriodMonthly,
+			Limit:  t.monthlyLimit,
+			Used:   t.monthlyUsed + t.reserved,
+		}
+	}
+
+	t.reserved += t.estimate
+
+	return nil
+}
+
+// release releases reserved credits of the request with unknown cost.
+func (t *Tracker) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.reserved -= t.estimate
+}
+
+// record replaces reserved credits of the request with its actual cost.
+func (t *Tracker) record(path string, tag string, credits int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.reset(t.clock.Now())
+
+	t.reserved -= t.estimate
+	t.dailyUsed += credits
+	t.monthlyUsed += credits
+	t.endpoints[path] = addUsage(t.endpoints[path], credits)
+	t.tags[tag] = addUsage(t.tags[tag], credits)
+}
+
+// reset resets daily and monthly usage when the period is over.
+func (t *Tracker) reset(now time.Time) {
+	if !now.Before(t.dayEnd) {
+		t.dailyUsed = 0
+		t.dayEnd = nextDay(now)
+	}
+
+	if !now.Before(t.monthEnd) {
+		t.monthlyUsed = 0
+
+		for !now.Before(t.monthEnd) {
+			t.monthEnd = t.monthEnd.AddDate(0, 1, 0)
+		}
+	}
+}
+
+func addUsage(usage Usage, credits int) Usage {
+	return Usage{
+		Requests: usage.Requests + 1,
+		Credits:  usage.Credits + credits,
+	}
+}
+
+func nextDay(now time.Time) time.Time {
+	year, month, day := now.UTC().Date()
+
+	return time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+}
+
+func nextMonth(now time.Time) time.Time {
+	year, month, _ := now.UTC().Date()
+
+	return time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// responseStatus returns status of the response implementing types.StatusProvider.
+func responseStatus(result any) (types.Status, bool) {
+	provider, ok := result.(types.StatusProvider)
+	if !ok {
+		return types.Status{}, false
+	}
+
+	return provider.GetStatus(), true
+}
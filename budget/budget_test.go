@@ -0,0 +1,238 @@
+package budget_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/api/key"
+	"github.com/Mikhalevich/coinmarketcap/budget"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	return f.now
+}
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.now = f.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- f.now
+
+	return ch
+}
+
+func expectCredits(mockExecutor *budget.MockExecutor, path string, credits int) {
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), path, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			rsp, ok := result.(*cryptocurrency.QuotesLatestResponse)
+			if !ok {
+				return nil
+			}
+
+			rsp.Status.CreditCount = credits
+
+			return nil
+		})
+}
+
+func TestTrackerSnapshot(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = budget.NewMockExecutor(ctrl)
+		clock        = &fakeClock{now: time.Date(2025, time.June, 28, 12, 0, 0, 0, time.UTC)}
+		tracker      = budget.New(mockExecutor, budget.WithClock(clock))
+		syncCtx      = budget.WithTag(t.Context(), "sync")
+	)
+
+	expectCredits(mockExecutor, "/v2/cryptocurrency/quotes/latest", 2)
+	expectCredits(mockExecutor, "/v2/cryptocurrency/quotes/latest", 3)
+	expectCredits(mockExecutor, "/v1/cryptocurrency/map", 1)
+
+	var rsp cryptocurrency.QuotesLatestResponse
+
+	require.NoError(t, tracker.Get(syncCtx, "/v2/cryptocurrency/quotes/latest", nil, &rsp))
+	require.NoError(t, tracker.Get(t.Context(), "/v2/cryptocurrency/quotes/latest", nil, &rsp))
+	require.NoError(t, tracker.Get(syncCtx, "/v1/cryptocurrency/map", nil, &rsp))
+
+	require.Equal(t, budget.Snapshot{
+		DailyUsed:   6,
+		MonthlyUsed: 6,
+		Endpoints: map[string]budget.Usage{
+			"/v2/cryptocurrency/quotes/latest": {Requests: 2, Credits: 5},
+			"/v1/cryptocurrency/map":           {Requests: 1, Credits: 1},
+		},
+		Tags: map[string]budget.Usage{
+			"sync": {Requests: 2, Credits: 3},
+			"":     {Requests: 1, Credits: 3},
+		},
+	}, tracker.Snapshot())
+
+	clock.now = time.Date(2025, time.June, 29, 0, 0, 0, 0, time.UTC)
+
+	snapshot := tracker.Snapshot()
+	require.Zero(t, snapshot.DailyUsed)
+	require.Equal(t, 6, snapshot.MonthlyUsed)
+
+	clock.now = time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	require.Zero(t, tracker.Snapshot().MonthlyUsed)
+}
+
+func TestTrackerExhausted(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = budget.NewMockExecutor(ctrl)
+		clock        = &fakeClock{now: time.Date(2025, time.June, 28, 12, 0, 0, 0, time.UTC)}
+		tracker      = budget.New(
+			mockExecutor,
+			budget.WithClock(clock),
+			budget.WithDailyLimit(100),
+			budget.WithMonthlyLimit(1000),
+			budget.WithUsage(key.KeyUsage{
+				CurrentDay:   key.KeyUsageCredits{CreditsUsed: 95},
+				CurrentMonth: key.KeyUsageCredits{CreditsUsed: 500},
+			}),
+		)
+		rsp cryptocurrency.QuotesLatestResponse
+	)
+
+	expectCredits(mockExecutor, "/v2/cryptocurrency/quotes/latest", 5)
+
+	require.NoError(t, tracker.Get(t.Context(), "/v2/cryptocurrency/quotes/latest", nil, &rsp))
+
+	err := tracker.Get(t.Context(), "/v2/cryptocurrency/quotes/latest", nil, &rsp)
+
+	var exhaustedErr *budget.ExhaustedError
+	require.ErrorAs(t, err, &exhaustedErr)
+	require.Equal(t, &budget.ExhaustedError{Period: budget.PeriodDaily, Limit: 100, Used: 100}, exhaustedErr)
+	require.ErrorIs(t, err, coinmarketcap.ErrCreditLimit)
+
+	clock.now = clock.now.Add(time.Hour * 12)
+
+	expectCredits(mockExecutor, "/v2/cryptocurrency/quotes/latest", 1)
+	require.NoError(t, tracker.Get(t.Context(), "/v2/cryptocurrency/quotes/latest", nil, &rsp))
+}
+
+func TestTrackerReserve(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = budget.NewMockExecutor(ctrl)
+		clock        = &fakeClock{now: time.Date(2025, time.June, 28, 12, 0, 0, 0, time.UTC)}
+		tracker      = budget.New(mockExecutor, budget.WithClock(clock), budget.WithDailyLimit(2))
+		started      = make(chan struct{})
+		release      = make(chan struct{})
+		errs         = make(chan error, 2)
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v2/cryptocurrency/quotes/latest", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			started <- struct{}{}
+			<-release
+
+			rsp, ok := result.(*cryptocurrency.QuotesLatestResponse)
+			if !ok {
+				return nil
+			}
+
+			rsp.Status.CreditCount = 1
+
+			return nil
+		}).
+		Times(2)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/cryptocurrency/map", gomock.Any(), gomock.Any()).
+		Return(errors.New("some error"))
+
+	require.Error(t, tracker.Get(t.Context(), "/v1/cryptocurrency/map", nil, &cryptocurrency.MapResponse{}))
+
+	for range 2 {
+		go func() {
+			errs <- tracker.Get(t.Context(), "/v2/cryptocurrency/quotes/latest", nil, &cryptocurrency.QuotesLatestResponse{})
+		}()
+
+		<-started
+	}
+
+	err := tracker.Get(t.Context(), "/v2/cryptocurrency/quotes/latest", nil, &cryptocurrency.QuotesLatestResponse{})
+	require.ErrorIs(t, err, coinmarketcap.ErrCreditLimit)
+
+	close(release)
+	require.NoError(t, <-errs)
+	require.NoError(t, <-errs)
+
+	require.Equal(t, 2, tracker.Snapshot().DailyUsed)
+}
+
+func TestFromKeyInfo(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = budget.NewMockExecutor(ctrl)
+		clock        = &fakeClock{now: time.Date(2025, time.June, 28, 12, 0, 0, 0, time.UTC)}
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/key/info", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			rsp, ok := result.(*key.KeyResponse)
+			require.True(t, ok)
+
+			rsp.Data.Plan.CreditLimitMonthly = 10000
+			rsp.Data.Plan.CreditLimitMonthlyResetTimestamp = time.Date(2025, time.July, 15, 0, 0, 0, 0, time.UTC)
+			rsp.Data.Usage.CurrentDay = key.KeyUsageCredits{CreditsUsed: 10, CreditsLeft: 323}
+			rsp.Data.Usage.CurrentMonth = key.KeyUsageCredits{CreditsUsed: 400, CreditsLeft: 9600}
+
+			return nil
+		})
+
+	tracker, err := budget.FromKeyInfo(t.Context(), mockExecutor, budget.WithClock(clock))
+	require.NoError(t, err)
+
+	snapshot := tracker.Snapshot()
+	require.Equal(t, 333, snapshot.DailyLimit)
+	require.Equal(t, 10, snapshot.DailyUsed)
+	require.Equal(t, 10000, snapshot.MonthlyLimit)
+	require.Equal(t, 400, snapshot.MonthlyUsed)
+
+	clock.now = time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, 400, tracker.Snapshot().MonthlyUsed)
+
+	clock.now = time.Date(2025, time.July, 15, 0, 0, 0, 0, time.UTC)
+	require.Zero(t, tracker.Snapshot().MonthlyUsed)
+}
@@ -0,0 +1,312 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+const (
+	defaultCapacity = 1000
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+// DefaultTTLs returns default time to live per endpoint path.
+// Endpoints missing in the map are not cached.
+func DefaultTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		"/v2/cryptocurrency/quotes/latest": time.Minute,
+		"/v1/cryptocurrency/map":           time.Hour * 24,
+		"/v2/cryptocurrency/info":          time.Hour * 24,
+		"/v1/fiat/map":                     time.Hour * 24,
+	}
+}
+
+type call struct {
+	done    chan struct{}
+	raw     json.RawMessage
+	err     error
+	waiters int
+}
+
+// Cache executor decorator caching successful responses.
+// Concurrent identical requests are executed once.
+// Safe for concurrent use.
+type Cache struct {
+	executor   Executor
+	storage    Storage
+	ttls       map[string]time.Duration
+	defaultTTL time.Duration
+	clock      coinmarketcap.Clock
+
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+type options struct {
+	Storage    Storage
+	TTLs       map[string]time.Duration
+	DefaultTTL time.Duration
+	Clock      coinmarketcap.Clock
+}
+
+// Option cache optional param.
+type Option func(opts *options)
+
+// WithStorage cache storage.
+// Default in-memory LRU with 1000 entries.
+func WithStorage(storage Storage) Option {
+	return func(opts *options) {
+		opts.Storage = storage
+	}
+}
+
+// WithTTL time to live of responses for endpoint path, non-positive ttl disables caching of the endpoint.
+// Overrides DefaultTTLs value.
+func WithTTL(path string, ttl time.Duration) Option {
+	return func(opts *options) {
+		opts.TTLs[path] = ttl
+	}
+}
+
+// WithDefaultTTL time to live of responses for endpoints without explicit ttl.
+// Default 0, such endpoints are not cached.
+func WithDefaultTTL(ttl time.Duration) Option {
+	return func(opts *options) {
+		opts.DefaultTTL = ttl
+	}
+}
+
+// WithClock time source, could be replaced in tests.
+// Default real time.
+func WithClock(clock coinmarketcap.Clock) Option {
+	return func(opts *options) {
+		opts.Clock = clock
+	}
+}
+
+// New construct caching decorator for executor.
+func New(executor Executor, opts ...Option) *Cache {
+	options := options{
+		TTLs:  DefaultTTLs(),
+		Clock: coinmarketcap.SystemClock{},
+	}
+
+	for _, option := range opts {
+		option(&options)
+	}
+
+	if options.Storage == nil {
+		options.Storage = NewLRU(defaultCapacity)
+	}
+
+	return &Cache{
+		executor:   executor,
+		storage:    options.Storage,
+		ttls:       options.TTLs,
+		defaultTTL: options.DefaultTTL,
+		clock:      options.Clock,
+		calls:      make(map[string]*call),
+	}
+}
+
+// Get returns cached response if it is not expired, otherwise executes request and caches response.
+// Responses with error status are not cached.
+func (c *Cache) Get(
+	ctx context.Context,
+	path string,
+	preProcessFn func(req *http.Request) error,
+	result any,
+) error {
+	ttl := c.ttl(path)
+	if ttl <= 0 {
+		if err := c.executor.Get(ctx, path, preProcessFn, result); err != nil {
+			return fmt.Errorf("cache: %w", err)
+		}
+
+		return nil
+	}
+
+	key, err := makeKey(ctx, path, preProcessFn)
+	if err != nil {
+		return fmt.Errorf("make cache key: %w", err)
+	}
+
+	if entry, ok := c.storage.Get(key); ok && c.clock.Now().Before(entry.ExpiresAt) {
+		if err := json.Unmarshal(entry.Value, result); err != nil {
+			return fmt.Errorf("json decode: %w", err)
+		}
+
+		return nil
+	}
+
+	raw, err := c.do(ctx, key, func(ctx context.Context) (json.RawMessage, error) {
+		var rsp response
+
+		if err := c.executor.Get(ctx, path, preProcessFn, &rsp); err != nil {
+			return nil, fmt.Errorf("cache: %w", err)
+		}
+
+		if !rsp.status.IsError() {
+			c.storage.Set(key, Entry{
+				Value:     rsp.raw,
+				ExpiresAt: c.clock.Now().Add(ttl),
+			})
+		}
+
+		return rsp.raw, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw, result); err != nil {
+		return fmt.Errorf("json decode: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Cache) ttl(path string) time.Duration {
+	if ttl, ok := c.ttls[path]; ok {
+		return ttl
+	}
+
+	return c.defaultTTL
+}
+
+// do executes fn once for concurrent calls with the same key.
+// fn is executed with context detached from the caller cancellation but keeping the caller deadline,
+// so cancellation of one caller doesn't fail the others, every caller waits with its own context.
+func (c *Cache) do(
+	ctx context.Context,
+	key string,
+	fn func(ctx context.Context) (json.RawMessage, error),
+) (json.RawMessage, error) {
+	c.mu.Lock()
+
+	current, ok := c.calls[key]
+	if !ok {
+		current = &call{
+			done: make(chan struct{}),
+		}
+		c.calls[key] = current
+
+		go func() {
+			fnCtx, cancel := detach(ctx)
+			defer cancel()
+
+			current.raw, current.err = fn(fnCtx)
+
+			c.mu.Lock()
+			delete(c.calls, key)
+			c.mu.Unlock()
+
+			close(current.done)
+		}()
+	}
+
+	current.waiters++
+
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		current.waiters--
+		c.mu.Unlock()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("wait response: %w", ctx.Err())
+	case <-current.done:
+		return current.raw, current.err
+	}
+}
+
+// detach returns context detached from ctx cancellation with the same deadline if ctx has one.
+func detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, deadline)
+	}
+
+	return detached, func() {}
+}
+
+// waiters returns the number of callers waiting for in flight requests.
+func (c *Cache) waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var count int
+
+	for _, inFlight := range c.calls {
+		count += inFlight.waiters
+	}
+
+	return count
+}
+
+// makeKey returns endpoint path with canonical query produced by preProcessFn.
+func makeKey(
+	ctx context.Context,
+	path string,
+	preProcessFn func(req *http.Request) error,
+) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return "", fmt.Errorf("create http request: %w", err)
+	}
+
+	if preProcessFn != nil {
+		if err := preProcessFn(req); err != nil {
+			return "", fmt.Errorf("pre process: %w", err)
+		}
+	}
+
+	return path + "?" + req.URL.Query().Encode(), nil
+}
+
+// response raw json response passed to the underlying executor.
+// Implements types.StatusProvider so the underlying executor decorators could read response status.
+type response struct {
+	raw    json.RawMessage
+	status types.Status
+}
+
+func (r *response) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		Status types.Status `json:"status"`
+	}
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("json decode status: %w", err)
+	}
+
+	r.raw = slices.Clone(data)
+	r.status = envelope.Status
+
+	return nil
+}
+
+// GetStatus returns response status.
+func (r *response) GetStatus() types.Status {
+	return r.status
+}
@@ -0,0 +1,348 @@
+package cache_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/fiat"
+	"github.com/Mikhalevich/coinmarketcap/budget"
+	"github.com/Mikhalevich/coinmarketcap/cache"
+)
+
+const (
+	fiatMapResponseBody = `{"data":[{"id":2781,"symbol":"USD"}],"status":{"error_code":0,"credit_count":1}}`
+	errorResponseBody   = `{"status":{"error_code":1008,"error_message":"rate limit"}}`
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- f.now
+
+	return ch
+}
+
+func queryFn(values url.Values) func(req *http.Request) error {
+	return func(req *http.Request) error {
+		req.URL.RawQuery = values.Encode()
+
+		return nil
+	}
+}
+
+func rawResponse(body string) func(context.Context, string, func(*http.Request) error, any) error {
+	return func(ctx context.Context, path string, preProcessFn func(req *http.Request) error, result any) error {
+		return json.Unmarshal([]byte(body), result)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cache.NewMockExecutor(ctrl)
+		clock        = &fakeClock{now: time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC)}
+		cch          = cache.New(mockExecutor, cache.WithClock(clock))
+		f            = fiat.New(cch)
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/fiat/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(rawResponse(fiatMapResponseBody)).
+		Times(3)
+
+	for range 2 {
+		rsp, err := f.Map(t.Context(), fiat.WithMapLimit(10), fiat.WithMapSort(fiat.MapSortName))
+		require.NoError(t, err)
+		require.Equal(t, "USD", rsp.Data[0].Symbol)
+	}
+
+	_, err := f.Map(t.Context(), fiat.WithMapLimit(20))
+	require.NoError(t, err)
+
+	clock.now = clock.now.Add(time.Hour * 24)
+
+	_, err = f.Map(t.Context(), fiat.WithMapLimit(10), fiat.WithMapSort(fiat.MapSortName))
+	require.NoError(t, err)
+}
+
+func TestCacheCanonicalQuery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cache.NewMockExecutor(ctrl)
+		cch          = cache.New(mockExecutor)
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/fiat/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(rawResponse(fiatMapResponseBody))
+
+	var rsp fiat.MapResponse
+
+	require.NoError(t, cch.Get(t.Context(), "/v1/fiat/map", func(req *http.Request) error {
+		req.URL.RawQuery = "sort=name&limit=10"
+
+		return nil
+	}, &rsp))
+
+	require.NoError(t, cch.Get(t.Context(), "/v1/fiat/map", queryFn(url.Values{
+		"limit": []string{"10"},
+		"sort":  []string{"name"},
+	}), &rsp))
+
+	require.Equal(t, 2781, rsp.Data[0].ID)
+}
+
+func TestCacheErrorStatusNotCached(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cache.NewMockExecutor(ctrl)
+		f            = fiat.New(cache.New(mockExecutor))
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/fiat/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(rawResponse(errorResponseBody)).
+		Times(2)
+
+	for range 2 {
+		_, err := f.Map(t.Context())
+		require.EqualError(t, err, "code: 1008 message: rate limit")
+	}
+}
+
+func TestCacheNotCachedEndpoint(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cache.NewMockExecutor(ctrl)
+		cch          = cache.New(mockExecutor, cache.WithTTL("/v1/fiat/map", 0))
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/fiat/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, preProcessFn func(req *http.Request) error, result any) error {
+			_, ok := result.(*fiat.MapResponse)
+			require.True(t, ok)
+
+			return nil
+		}).
+		Times(2)
+
+	for range 2 {
+		var rsp fiat.MapResponse
+		require.NoError(t, cch.Get(t.Context(), "/v1/fiat/map", nil, &rsp))
+	}
+}
+
+func TestCacheSingleflight(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cache.NewMockExecutor(ctrl)
+		c            = cache.New(mockExecutor)
+		f            = fiat.New(c)
+		started      = make(chan struct{})
+		release      = make(chan struct{})
+		wg           sync.WaitGroup
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/fiat/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, preProcessFn func(req *http.Request) error, result any) error {
+			close(started)
+			<-release
+
+			return rawResponse(fiatMapResponseBody)(ctx, path, preProcessFn, result)
+		})
+
+	results := make([]*fiat.MapResponse, 5)
+	errs := make([]error, 5)
+
+	for i := range results {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if i > 0 {
+				<-started
+			}
+
+			results[i], errs[i] = f.Map(t.Context())
+		}()
+	}
+
+	<-started
+	require.Eventually(t, func() bool {
+		return cache.Waiters(c) == len(results)
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i := range results {
+		require.NoError(t, errs[i])
+		require.Equal(t, 2781, results[i].Data[0].ID)
+	}
+}
+
+func TestLRU(t *testing.T) {
+	t.Parallel()
+
+	lru := cache.NewLRU(2)
+
+	lru.Set("a", cache.Entry{Value: []byte("a")})
+	lru.Set("b", cache.Entry{Value: []byte("b")})
+
+	_, ok := lru.Get("a")
+	require.True(t, ok)
+
+	lru.Set("c", cache.Entry{Value: []byte("c")})
+
+	require.Equal(t, 2, lru.Len())
+
+	_, ok = lru.Get("b")
+	require.False(t, ok)
+
+	entry, ok := lru.Get("a")
+	require.True(t, ok)
+	require.Equal(t, []byte("a"), entry.Value)
+}
+
+func TestCacheSingleflightLeaderCancel(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl                = gomock.NewController(t)
+		mockExecutor        = cache.NewMockExecutor(ctrl)
+		c                   = cache.New(mockExecutor)
+		f                   = fiat.New(c)
+		started             = make(chan struct{})
+		release             = make(chan struct{})
+		leaderCtx, cancelFn = context.WithCancel(t.Context())
+		leaderErr           = make(chan error, 1)
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/fiat/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, preProcessFn func(req *http.Request) error, result any) error {
+			close(started)
+			<-release
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			return rawResponse(fiatMapResponseBody)(ctx, path, preProcessFn, result)
+		})
+
+	go func() {
+		_, err := f.Map(leaderCtx)
+		leaderErr <- err
+	}()
+
+	<-started
+
+	followerRsp := make(chan *fiat.MapResponse, 1)
+	followerErr := make(chan error, 1)
+
+	go func() {
+		rsp, err := f.Map(t.Context())
+		followerRsp <- rsp
+		followerErr <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		return cache.Waiters(c) == 2
+	}, time.Second, time.Millisecond)
+
+	cancelFn()
+	require.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	close(release)
+
+	require.NoError(t, <-followerErr)
+	require.Equal(t, 2781, (<-followerRsp).Data[0].ID)
+}
+
+func TestCacheSingleflightKeepsDeadline(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cache.NewMockExecutor(ctrl)
+		f            = fiat.New(cache.New(mockExecutor))
+		deadline     = time.Now().Add(time.Minute)
+		deadlines    = make(chan time.Time, 1)
+	)
+
+	ctx, cancel := context.WithDeadline(t.Context(), deadline)
+	defer cancel()
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/fiat/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, preProcessFn func(req *http.Request) error, result any) error {
+			executorDeadline, _ := ctx.Deadline()
+			deadlines <- executorDeadline
+
+			return rawResponse(fiatMapResponseBody)(ctx, path, preProcessFn, result)
+		})
+
+	_, err := f.Map(ctx)
+	require.NoError(t, err)
+	require.Equal(t, deadline, <-deadlines)
+}
+
+func TestCacheOverBudgetTracker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = cache.NewMockExecutor(ctrl)
+		tracker      = budget.New(mockExecutor)
+		f            = fiat.New(cache.New(tracker))
+	)
+
+	mockExecutor.EXPECT().
+		Get(gomock.Any(), "/v1/fiat/map", gomock.Any(), gomock.Any()).
+		DoAndReturn(rawResponse(fiatMapResponseBody))
+
+	for range 2 {
+		rsp, err := f.Map(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, 2781, rsp.Data[0].ID)
+	}
+
+	require.Equal(t, budget.Usage{Requests: 1, Credits: 1}, tracker.Snapshot().Endpoints["/v1/fiat/map"])
+}
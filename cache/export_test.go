@@ -0,0 +1,6 @@
+package cache
+
+// Waiters returns the number of callers waiting for in flight requests.
+func Waiters(c *Cache) int {
+	return c.waiters()
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Entry cached raw response.
+type Entry struct {
+	Value     []byte
+	ExpiresAt time.Time
+}
+
+// Storage pluggable cache storage.
+// Implementations should be safe for concurrent use, expired entries are skipped by the cache.
+type Storage interface {
+	Get(key string) (Entry, bool)
+	Set(key string, entry Entry)
+}
+
+type lruItem struct {
+	key   string
+	entry Entry
+}
+
+// LRU in-memory storage evicting least recently used entries.
+type LRU struct {
+	capacity int
+
+	mu    sync.Mutex
+	order *list.List
+	items map[string]*list.Element
+}
+
+// NewLRU construct in-memory storage holding at most capacity entries.
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		capacity: max(capacity, 1),
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (l *LRU) Get(key string) (Entry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elem, ok := l.items[key]
+	if !ok {
+		return Entry{}, false
+	}
+
+	l.order.MoveToFront(elem)
+
+	//nolint:forcetypeassert
+	return elem.Value.(*lruItem).entry, true
+}
+
+func (l *LRU) Set(key string, entry Entry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elem, ok := l.items[key]; ok {
+		//nolint:forcetypeassert
+		elem.Value.(*lruItem).entry = entry
+		l.order.MoveToFront(elem)
+
+		return
+	}
+
+	l.items[key] = l.order.PushFront(&lruItem{
+		key:   key,
+		entry: entry,
+	})
+
+	if l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+
+		//nolint:forcetypeassert
+		delete(l.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Len returns the number of stored entries.
+func (l *LRU) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.order.Len()
+}
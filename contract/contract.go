@@ -0,0 +1,52 @@
+package contract
+
+import (
+	"strconv"
+
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+// Network struct for dex network representation.
+type Network struct {
+	ID   string
+	Slug string
+}
+
+// NetworkID create network from id.
+func NetworkID(id int) Network {
+	return Network{
+		ID: strconv.Itoa(id),
+	}
+}
+
+// NetworkSlug create network from slug.
+func NetworkSlug(slug string) Network {
+	return Network{
+		Slug: slug,
+	}
+}
+
+// Contract struct for contract address on specific network representation.
+// Used for identifying dex pairs and assets.
+type Contract struct {
+	Address string
+	Network Network
+}
+
+// New create contract from address and network.
+func New(address string, network Network) Contract {
+	return Contract{
+		Address: address,
+		Network: network,
+	}
+}
+
+// FromPlatform create contract from cryptocurrency platform token address on dex network.
+// Cryptocurrency platform slugs and dex network slugs are different namespaces (e.g. "bnb" and "bsc"),
+// so network should be resolved separately, e.g. with dex networks endpoint.
+func FromPlatform(platform types.PlatformV2, network Network) Contract {
+	return Contract{
+		Address: platform.TokenAdress,
+		Network: network,
+	}
+}
@@ -1,12 +1,31 @@
 package coinmarketcap
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+)
+
+// Error kinds, use errors.Is to check the kind of *Error.
+var (
+	// ErrInvalidAPIKey api key is invalid or missing (1001, 1002, 1005 or http 401).
+	ErrInvalidAPIKey = errors.New("invalid api key")
+	// ErrAPIKeyDisabled api key is disabled or plan payment is required (1003, 1004, 1007).
+	ErrAPIKeyDisabled = errors.New("api key disabled")
+	// ErrPlanRestricted endpoint is not available for the api key plan (1006 or http 403).
+	ErrPlanRestricted = errors.New("plan restricted")
+	// ErrRateLimited minute or ip rate limit is reached (1008, 1011 or http 429).
+	ErrRateLimited = errors.New("rate limited")
+	// ErrCreditLimit daily or monthly credit limit is reached (1009, 1010).
+	ErrCreditLimit = errors.New("credit limit reached")
+	// ErrServer server side error (http 5xx).
+	ErrServer = errors.New("server error")
 )
 
 type Error struct {
-	Code    int
-	Message string
+	Code       int
+	Message    string
+	HTTPStatus int
 }
 
 func NewError(code int, message string) *Error {
@@ -16,6 +35,69 @@ func NewError(code int, message string) *Error {
 	}
 }
 
+// NewHTTPError constructs error with http status of the response.
+func NewHTTPError(httpStatus int, code int, message string) *Error {
+	return &Error{
+		Code:       code,
+		Message:    message,
+		HTTPStatus: httpStatus,
+	}
+}
+
 func (e *Error) Error() string {
+	if e.HTTPStatus != 0 {
+		return fmt.Sprintf("http status: %d code: %d message: %s", e.HTTPStatus, e.Code, e.Message)
+	}
+
 	return fmt.Sprintf("code: %d message: %s", e.Code, e.Message)
 }
+
+// Is reports whether error is of kind specified by sentinel error target.
+func (e *Error) Is(target error) bool {
+	kind := e.kind()
+
+	return kind != nil && kind == target
+}
+
+// kind returns sentinel error by api error code falling back to http status.
+//
+//nolint:cyclop,mnd
+func (e *Error) kind() error {
+	switch e.Code {
+	case 1001, 1002, 1005:
+		return ErrInvalidAPIKey
+	case 1003, 1004, 1007:
+		return ErrAPIKeyDisabled
+	case 1006:
+		return ErrPlanRestricted
+	case 1008, 1011:
+		return ErrRateLimited
+	case 1009, 1010:
+		return ErrCreditLimit
+	}
+
+	switch {
+	case e.HTTPStatus == http.StatusUnauthorized:
+		return ErrInvalidAPIKey
+	case e.HTTPStatus == http.StatusForbidden:
+		return ErrPlanRestricted
+	case e.HTTPStatus == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.HTTPStatus >= http.StatusInternalServerError:
+		return ErrServer
+	}
+
+	return nil
+}
+
+// IsRateLimited reports whether err is caused by minute or ip rate limit.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsRetryable reports whether request failed with err could succeed later:
+// rate limit is reached or server side error occurred.
+// Credit limits are not retryable as they are reset only on the next day or month.
+func IsRetryable(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrServer)
+}
@@ -0,0 +1,125 @@
+package coinmarketcap_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap"
+)
+
+func TestErrorIs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		err         *coinmarketcap.Error
+		kind        error
+		rateLimited bool
+		retryable   bool
+	}{
+		{name: "invalid key", err: coinmarketcap.NewError(1001, "invalid"), kind: coinmarketcap.ErrInvalidAPIKey},
+		{name: "missing key", err: coinmarketcap.NewError(1002, "missing"), kind: coinmarketcap.ErrInvalidAPIKey},
+		{name: "key disabled", err: coinmarketcap.NewError(1007, "disabled"), kind: coinmarketcap.ErrAPIKeyDisabled},
+		{name: "plan restricted", err: coinmarketcap.NewError(1006, "plan"), kind: coinmarketcap.ErrPlanRestricted},
+		{
+			name:        "minute rate limit",
+			err:         coinmarketcap.NewHTTPError(http.StatusTooManyRequests, 1008, "minute"),
+			kind:        coinmarketcap.ErrRateLimited,
+			rateLimited: true,
+			retryable:   true,
+		},
+		{
+			name:        "ip rate limit",
+			err:         coinmarketcap.NewError(1011, "ip"),
+			kind:        coinmarketcap.ErrRateLimited,
+			rateLimited: true,
+			retryable:   true,
+		},
+		{name: "daily credits", err: coinmarketcap.NewError(1009, "daily"), kind: coinmarketcap.ErrCreditLimit},
+		{
+			name: "monthly credits",
+			err:  coinmarketcap.NewHTTPError(http.StatusTooManyRequests, 1010, "monthly"),
+			kind: coinmarketcap.ErrCreditLimit,
+		},
+		{
+			name:      "bad gateway",
+			err:       coinmarketcap.NewHTTPError(http.StatusBadGateway, 0, "Bad Gateway"),
+			kind:      coinmarketcap.ErrServer,
+			retryable: true,
+		},
+		{
+			name: "forbidden",
+			err:  coinmarketcap.NewHTTPError(http.StatusForbidden, 0, "Forbidden"),
+			kind: coinmarketcap.ErrPlanRestricted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			wrapped := fmt.Errorf("execute get request: %w", test.err)
+
+			require.ErrorIs(t, wrapped, test.kind)
+			require.Equal(t, test.rateLimited, coinmarketcap.IsRateLimited(wrapped))
+			require.Equal(t, test.retryable, coinmarketcap.IsRetryable(wrapped))
+		})
+	}
+}
+
+func TestHTTPErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl     = gomock.NewController(t)
+		doer     = coinmarketcap.NewMockHTTPDoer(ctrl)
+		executor = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer)
+	)
+
+	doer.EXPECT().
+		Do(gomock.Any()).
+		Return(&http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Body: io.NopCloser(strings.NewReader(
+				`{"status":{"error_code":1002,"error_message":"API key missing."}}`,
+			)),
+		}, nil)
+
+	err := getInfo(t.Context(), executor)
+
+	require.Equal(t, coinmarketcap.NewHTTPError(http.StatusUnauthorized, 1002, "API key missing."), err)
+	require.ErrorIs(t, err, coinmarketcap.ErrInvalidAPIKey)
+	require.False(t, coinmarketcap.IsRetryable(err))
+}
+
+func TestHTTPErrorStringCode(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl     = gomock.NewController(t)
+		doer     = coinmarketcap.NewMockHTTPDoer(ctrl)
+		executor = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer)
+	)
+
+	doer.EXPECT().
+		Do(gomock.Any()).
+		Return(&http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Body: io.NopCloser(strings.NewReader(
+				`{"status":{"error_code":"1008","error_message":"You've exceeded your API Key's HTTP request rate limit."}}`,
+			)),
+		}, nil)
+
+	err := getInfo(t.Context(), executor)
+
+	require.Equal(t, coinmarketcap.NewHTTPError(http.StatusTooManyRequests, 1008,
+		"You've exceeded your API Key's HTTP request rate limit."), err)
+	require.ErrorIs(t, err, coinmarketcap.ErrRateLimited)
+	require.True(t, coinmarketcap.IsRetryable(err))
+}
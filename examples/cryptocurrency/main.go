@@ -11,6 +11,7 @@ import (
 
 	"github.com/Mikhalevich/coinmarketcap"
 	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+	"github.com/Mikhalevich/coinmarketcap/api/fiat"
 	"github.com/Mikhalevich/coinmarketcap/currency"
 )
 
@@ -18,7 +19,6 @@ const (
 	timeout = time.Second * 5
 	btcID   = 1
 	ltcID   = 2
-	usdID   = 2781
 )
 
 func main() {
@@ -32,10 +32,22 @@ func main() {
 		log          = slog.New(slog.NewTextHandler(os.Stdout, nil))
 	)
 
+	fiatRegistry, err := fiat.New(prodExecutor).Registry(context.Background())
+	if err != nil {
+		log.Error("request fiat registry", "error", err.Error())
+		os.Exit(1)
+	}
+
+	usd, ok := fiatRegistry.Currency("USD")
+	if !ok {
+		log.Error("usd is missing in fiat registry")
+		os.Exit(1)
+	}
+
 	quotes, err := cryptoc.QuotesLatest(
 		context.Background(),
 		[]currency.Currency{currency.ID(btcID), currency.ID(ltcID)},
-		[]currency.Currency{currency.ID(usdID)},
+		[]currency.Currency{usd},
 		cryptocurrency.WithQLSkipInvalid(false),
 	)
 	if err != nil {
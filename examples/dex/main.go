@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/dex"
+	"github.com/Mikhalevich/coinmarketcap/contract"
+)
+
+const (
+	timeout  = time.Second * 5
+	pairsNum = 5
+)
+
+func main() {
+	var (
+		client = http.Client{
+			Timeout: timeout,
+		}
+
+		prodExecutor = coinmarketcap.ProductionExecutor(os.Getenv("COIN_MARKET_CAP_KEY"), &client)
+		dx           = dex.New(prodExecutor)
+		log          = slog.New(slog.NewTextHandler(os.Stdout, nil))
+		ethereum     = contract.NetworkSlug("ethereum")
+	)
+
+	pairs, err := dx.SpotPairsLatest(
+		context.Background(),
+		ethereum,
+		dex.WithSPLimit(pairsNum),
+	)
+	if err != nil {
+		log.Error("request dex spot pairs latest", "error", err.Error())
+		os.Exit(1)
+	}
+
+	jsonPrint(pairs, log)
+
+	if len(pairs.Data) == 0 {
+		return
+	}
+
+	quotes, err := dx.PairQuotesLatest(
+		context.Background(),
+		[]contract.Contract{contract.New(pairs.Data[0].ContractAddress, ethereum)},
+	)
+	if err != nil {
+		log.Error("request dex pair quotes latest", "error", err.Error())
+		os.Exit(1)
+	}
+
+	jsonPrint(quotes, log)
+}
+
+func jsonPrint(response any, log *slog.Logger) {
+	bytes, err := json.MarshalIndent(response, "", "	")
+	if err != nil {
+		log.Error("marshal json", "error", err.Error())
+		os.Exit(1)
+	}
+
+	fmt.Fprintln(os.Stdout, string(bytes))
+}
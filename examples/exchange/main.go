@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/exchange"
+)
+
+const (
+	timeout   = time.Second * 5
+	binanceID = 270
+)
+
+func main() {
+	var (
+		client = http.Client{
+			Timeout: timeout,
+		}
+
+		prodExecutor = coinmarketcap.ProductionExecutor(os.Getenv("COIN_MARKET_CAP_KEY"), &client)
+		exch         = exchange.New(prodExecutor)
+		log          = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	)
+
+	mappings, err := exch.Map(
+		context.Background(),
+		exchange.WithMapSlug("binance"),
+	)
+	if err != nil {
+		log.Error("request exchange map", "error", err.Error())
+		os.Exit(1)
+	}
+
+	jsonPrint(mappings, log)
+
+	quotes, err := exch.QuotesLatest(
+		context.Background(),
+		[]exchange.Identifier{exchange.ID(binanceID)},
+	)
+	if err != nil {
+		log.Error("request exchange quotes latest", "error", err.Error())
+		os.Exit(1)
+	}
+
+	jsonPrint(quotes, log)
+}
+
+func jsonPrint(response any, log *slog.Logger) {
+	bytes, err := json.MarshalIndent(response, "", "	")
+	if err != nil {
+		log.Error("marshal json", "error", err.Error())
+		os.Exit(1)
+	}
+
+	fmt.Fprintln(os.Stdout, string(bytes))
+}
@@ -30,7 +30,7 @@ func main() {
 
 	quotes, err := f.Map(
 		context.Background(),
-		fiat.WithMapSort("name"),
+		fiat.WithMapSort(fiat.MapSortName),
 		fiat.WithMapMetals(true),
 	)
 	if err != nil {
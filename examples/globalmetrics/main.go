@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/globalmetrics"
+	"github.com/Mikhalevich/coinmarketcap/api/types"
+)
+
+const (
+	timeout = time.Second * 5
+)
+
+func main() {
+	var (
+		client = http.Client{
+			Timeout: timeout,
+		}
+
+		prodExecutor = coinmarketcap.ProductionExecutor(os.Getenv("COIN_MARKET_CAP_KEY"), &client)
+		metrics      = globalmetrics.New(prodExecutor)
+		log          = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	)
+
+	latest, err := metrics.QuotesLatest(context.Background())
+	if err != nil {
+		log.Error("request global metrics quotes latest", "error", err.Error())
+		os.Exit(1)
+	}
+
+	jsonPrint(latest, log)
+
+	historical, err := metrics.QuotesHistorical(
+		context.Background(),
+		globalmetrics.WithQHInterval(types.IntervalDaily),
+		globalmetrics.WithQHCount(7),
+	)
+	if err != nil {
+		log.Error("request global metrics quotes historical", "error", err.Error())
+		os.Exit(1)
+	}
+
+	jsonPrint(historical, log)
+}
+
+func jsonPrint(response any, log *slog.Logger) {
+	bytes, err := json.MarshalIndent(response, "", "	")
+	if err != nil {
+		log.Error("marshal json", "error", err.Error())
+		os.Exit(1)
+	}
+
+	fmt.Fprintln(os.Stdout, string(bytes))
+}
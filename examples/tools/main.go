@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/tools"
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+const (
+	timeout = time.Second * 5
+	btcID   = 1
+)
+
+func main() {
+	var (
+		client = http.Client{
+			Timeout: timeout,
+		}
+
+		prodExecutor = coinmarketcap.ProductionExecutor(os.Getenv("COIN_MARKET_CAP_KEY"), &client)
+		tls          = tools.New(prodExecutor)
+		log          = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	)
+
+	conversion, err := tls.PriceConversion(
+		context.Background(),
+		1,
+		currency.ID(btcID),
+		[]currency.Currency{currency.Symbol("USD"), currency.Symbol("EUR")},
+	)
+	if err != nil {
+		log.Error("request price conversion", "error", err.Error())
+		os.Exit(1)
+	}
+
+	jsonPrint(conversion, log)
+}
+
+func jsonPrint(response any, log *slog.Logger) {
+	bytes, err := json.MarshalIndent(response, "", "	")
+	if err != nil {
+		log.Error("marshal json", "error", err.Error())
+		os.Exit(1)
+	}
+
+	fmt.Fprintln(os.Stdout, string(bytes))
+}
@@ -1,4 +1,16 @@
 package coinmarketcap
 
 //go:generate go tool mockgen -source=./request_executor.go -destination=./request_executor_mock.go -package=coinmarketcap
+//go:generate go tool mockgen -source=./api/blockchain/blockchain.go -destination=./api/blockchain/blockchain_mock.go -package=blockchain
+//go:generate go tool mockgen -source=./api/content/content.go -destination=./api/content/content_mock.go -package=content
 //go:generate go tool mockgen -source=./api/cryptocurrency/cryptocurrency.go -destination=./api/cryptocurrency/cryptocurrency_mock.go -package=cryptocurrency
+//go:generate go tool mockgen -source=./api/dex/dex.go -destination=./api/dex/dex_mock.go -package=dex
+//go:generate go tool mockgen -source=./api/exchange/exchange.go -destination=./api/exchange/exchange_mock.go -package=exchange
+//go:generate go tool mockgen -source=./api/feargreed/feargreed.go -destination=./api/feargreed/feargreed_mock.go -package=feargreed
+//go:generate go tool mockgen -source=./api/fiat/fiat.go -destination=./api/fiat/fiat_mock.go -package=fiat
+//go:generate go tool mockgen -source=./api/globalmetrics/globalmetrics.go -destination=./api/globalmetrics/globalmetrics_mock.go -package=globalmetrics
+//go:generate go tool mockgen -source=./api/index/index.go -destination=./api/index/index_mock.go -package=index
+//go:generate go tool mockgen -source=./api/tools/tools.go -destination=./api/tools/tools_mock.go -package=tools
+//go:generate go tool mockgen -source=./budget/budget.go -destination=./budget/budget_mock.go -package=budget
+//go:generate go tool mockgen -source=./cache/cache.go -destination=./cache/cache_mock.go -package=cache
+//go:generate go tool mockgen -source=./ratelimit/ratelimit.go -destination=./ratelimit/ratelimit_mock.go -package=ratelimit
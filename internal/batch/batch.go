@@ -0,0 +1,66 @@
+package batch
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// Result result of single batch request.
+type Result[T any, R any] struct {
+	Items    []T
+	Response R
+	Err      error
+}
+
+// Run splits items into batches of at most size items and calls fn for every batch
+// with at most concurrency calls in flight.
+// Results are returned in batch order, batches not started because of context cancellation
+// contain context error.
+func Run[T any, R any](
+	ctx context.Context,
+	items []T,
+	size int,
+	concurrency int,
+	fn func(ctx context.Context, items []T) (R, error),
+) []Result[T, R] {
+	var (
+		batches   = slices.Collect(slices.Chunk(items, size))
+		results   = make([]Result[T, R], len(batches))
+		semaphore = make(chan struct{}, max(concurrency, 1))
+		wg        sync.WaitGroup
+	)
+
+	for i, batch := range batches {
+		results[i].Items = batch
+
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+
+			continue
+		case semaphore <- struct{}{}:
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			results[i].Response, results[i].Err = fn(ctx, batch)
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,89 @@
+package batch_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/coinmarketcap/internal/batch"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var (
+		inFlight    atomic.Int32
+		maxInFlight atomic.Int32
+		errBatch    = errors.New("batch error")
+	)
+
+	results := batch.Run(
+		t.Context(),
+		[]int{1, 2, 3, 4, 5, 6, 7},
+		3,
+		2,
+		func(_ context.Context, items []int) (int, error) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			for {
+				prev := maxInFlight.Load()
+				if current <= prev || maxInFlight.CompareAndSwap(prev, current) {
+					break
+				}
+			}
+
+			if items[0] == 4 {
+				return 0, errBatch
+			}
+
+			sum := 0
+			for _, item := range items {
+				sum += item
+			}
+
+			return sum, nil
+		},
+	)
+
+	require.Len(t, results, 3)
+	require.LessOrEqual(t, maxInFlight.Load(), int32(2))
+
+	require.Equal(t, []int{1, 2, 3}, results[0].Items)
+	require.Equal(t, 6, results[0].Response)
+	require.NoError(t, results[0].Err)
+
+	require.Equal(t, []int{4, 5, 6}, results[1].Items)
+	require.ErrorIs(t, results[1].Err, errBatch)
+
+	require.Equal(t, []int{7}, results[2].Items)
+	require.Equal(t, 7, results[2].Response)
+	require.NoError(t, results[2].Err)
+}
+
+func TestRunContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx, cancel = context.WithCancel(t.Context())
+		calls       atomic.Int32
+	)
+
+	cancel()
+
+	results := batch.Run(ctx, []int{1, 2, 3}, 1, 1, func(_ context.Context, items []int) (int, error) {
+		calls.Add(1)
+
+		return items[0], nil
+	})
+
+	require.Len(t, results, 3)
+	require.Zero(t, calls.Load())
+
+	for _, result := range results {
+		require.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
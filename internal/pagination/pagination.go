@@ -0,0 +1,81 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+const (
+	// DefaultLimit page size used when non-positive limit is specified.
+	DefaultLimit = 5000
+)
+
+// PageFunc requests single page of items starting from 1-based start offset
+// with at most limit items.
+type PageFunc[T any] func(ctx context.Context, start int, limit int) ([]T, error)
+
+// All returns iterator over all items of start/limit paginated endpoint.
+// Pages are requested lazily until an empty page or a page shorter than limit is returned.
+// Non-positive limit is replaced with DefaultLimit.
+// Request and context errors are yielded once with zero item and stop iteration.
+func All[T any](
+	ctx context.Context,
+	start int,
+	limit int,
+	fetchPage PageFunc[T],
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		start := max(start, 1)
+
+		if limit <= 0 {
+			limit = DefaultLimit
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, fmt.Errorf("context done: %w", err))
+
+				return
+			}
+
+			items, err := fetchPage(ctx, start, limit)
+			if err != nil {
+				yield(zero, fmt.Errorf("fetch page start %d: %w", start, err))
+
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(items) == 0 || len(items) < limit {
+				return
+			}
+
+			start += len(items)
+		}
+	}
+}
+
+// AllWithOptions returns iterator over all items of endpoint configured with functional options.
+// Start and limit are initial values taken from withOpts, pageOpts makes options for the requested page
+// which are appended to withOpts for every fetch call.
+func AllWithOptions[T any, O any](
+	ctx context.Context,
+	withOpts []O,
+	start int,
+	limit int,
+	pageOpts func(start int, limit int) []O,
+	fetch func(ctx context.Context, opts ...O) ([]T, error),
+) iter.Seq2[T, error] {
+	return All(ctx, start, limit, func(ctx context.Context, start int, limit int) ([]T, error) {
+		return fetch(ctx, slices.Concat(withOpts, pageOpts(start, limit))...)
+	})
+}
@@ -0,0 +1,215 @@
+package pagination_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/internal/pagination"
+)
+
+func pageFromSlice(items []int, starts *[]int) pagination.PageFunc[int] {
+	return func(_ context.Context, start int, limit int) ([]int, error) {
+		*starts = append(*starts, start)
+
+		from := min(start-1, len(items))
+		to := min(from+limit, len(items))
+
+		return items[from:to], nil
+	}
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		items  = []int{1, 2, 3, 4, 5, 6, 7}
+		starts []int
+		result []int
+	)
+
+	for item, err := range pagination.All(t.Context(), 1, 3, pageFromSlice(items, &starts)) {
+		require.NoError(t, err)
+
+		result = append(result, item)
+	}
+
+	require.Equal(t, items, result)
+	require.Equal(t, []int{1, 4, 7}, starts)
+}
+
+func TestAllExactPages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		starts []int
+		result []int
+	)
+
+	for item, err := range pagination.All(t.Context(), 1, 2, pageFromSlice([]int{1, 2, 3, 4}, &starts)) {
+		require.NoError(t, err)
+
+		result = append(result, item)
+	}
+
+	require.Equal(t, []int{1, 2, 3, 4}, result)
+	require.Equal(t, []int{1, 3, 5}, starts)
+}
+
+func TestAllBreak(t *testing.T) {
+	t.Parallel()
+
+	var starts []int
+
+	for item, err := range pagination.All(t.Context(), 1, 2, pageFromSlice([]int{1, 2, 3, 4, 5}, &starts)) {
+		require.NoError(t, err)
+
+		if item == 3 {
+			break
+		}
+	}
+
+	require.Equal(t, []int{1, 3}, starts)
+}
+
+func TestAllPageError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls   int
+		errs    []error
+		results []int
+	)
+
+	fetchPage := func(_ context.Context, start int, limit int) ([]int, error) {
+		calls++
+
+		if start > 1 {
+			return nil, coinmarketcap.NewError(1008, "rate limit")
+		}
+
+		return []int{1, 2}, nil
+	}
+
+	for item, err := range pagination.All(t.Context(), 1, 2, fetchPage) {
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		results = append(results, item)
+	}
+
+	require.Equal(t, 2, calls)
+	require.Equal(t, []int{1, 2}, results)
+	require.Len(t, errs, 1)
+
+	var cmcErr *coinmarketcap.Error
+	require.ErrorAs(t, errs[0], &cmcErr)
+}
+
+func TestAllContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx, cancel = context.WithCancel(t.Context())
+		starts      []int
+		errs        []error
+	)
+
+	defer cancel()
+
+	for _, err := range pagination.All(ctx, 1, 2, pageFromSlice([]int{1, 2, 3, 4, 5}, &starts)) {
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		cancel()
+	}
+
+	require.Equal(t, []int{1}, starts)
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], context.Canceled)
+}
+
+func TestAllNonPositiveLimit(t *testing.T) {
+	t.Parallel()
+
+	var limits []int
+
+	fetchPage := func(_ context.Context, start int, limit int) ([]int, error) {
+		limits = append(limits, limit)
+
+		return []int{1, 2}, nil
+	}
+
+	for _, limit := range []int{0, -1} {
+		for _, err := range pagination.All(t.Context(), 1, limit, fetchPage) {
+			require.NoError(t, err)
+		}
+	}
+
+	require.Equal(t, []int{pagination.DefaultLimit, pagination.DefaultLimit}, limits)
+}
+
+func TestAllEmptyPage(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	fetchPage := func(_ context.Context, start int, limit int) ([]int, error) {
+		calls++
+
+		if calls > 1 {
+			t.Fatal("empty page should stop iteration")
+		}
+
+		return nil, nil
+	}
+
+	for _, err := range pagination.All(t.Context(), 1, 0, fetchPage) {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 1, calls)
+}
+
+func TestAllWithOptions(t *testing.T) {
+	t.Parallel()
+
+	var pageOpts [][]string
+
+	fetch := func(_ context.Context, opts ...string) ([]int, error) {
+		pageOpts = append(pageOpts, opts)
+
+		if len(pageOpts) > 1 {
+			return []int{3}, nil
+		}
+
+		return []int{1, 2}, nil
+	}
+
+	makePageOpts := func(start int, limit int) []string {
+		return []string{"start=" + strconv.Itoa(start), "limit=" + strconv.Itoa(limit)}
+	}
+
+	var result []int
+
+	for item, err := range pagination.AllWithOptions(t.Context(), []string{"sort=id"}, 1, 2, makePageOpts, fetch) {
+		require.NoError(t, err)
+
+		result = append(result, item)
+	}
+
+	require.Equal(t, []int{1, 2, 3}, result)
+	require.Equal(t, [][]string{
+		{"sort=id", "start=1", "limit=2"},
+		{"sort=id", "start=3", "limit=2"},
+	}, pageOpts)
+}
@@ -0,0 +1,21 @@
+// Package querytest helps to check url query built by api requests in tests.
+package querytest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// RawQuery applies request pre process function to an empty request and returns its raw url query.
+func RawQuery(t *testing.T, ctx context.Context, preProcessFn func(req *http.Request) error) string {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+	require.NoError(t, err)
+	require.NoError(t, preProcessFn(req))
+
+	return req.URL.RawQuery
+}
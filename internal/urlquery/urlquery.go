@@ -0,0 +1,173 @@
+package urlquery
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap/currency"
+)
+
+const (
+	comma = ","
+)
+
+// AddCurrencies adds currencies under id, symbol or slug key depending on the first currency.
+// Nothing is added for empty currencies.
+func AddCurrencies(query url.Values, currencies []currency.Currency) {
+	addCurrencies(query, CurrencyKey(currencies), currencies)
+}
+
+// AddConvert adds convert currencies under convert_id or convert key depending on the first currency.
+// Nothing is added for empty currencies or currencies specified by slug, convert by slug is not supported.
+func AddConvert(query url.Values, currencies []currency.Currency) {
+	addCurrencies(query, ConvertKey(currencies), currencies)
+}
+
+// AddMatched adds matched currencies under matched_id or matched_symbol key depending on the first currency.
+// Nothing is added for empty currencies or currencies specified by slug, matched by slug is not supported.
+func AddMatched(query url.Values, currencies []currency.Currency) {
+	addCurrencies(query, MatchedKey(currencies), currencies)
+}
+
+func addCurrencies(query url.Values, key string, currencies []currency.Currency) {
+	if key == "" {
+		return
+	}
+
+	query.Add(key, CommaSeparated(CurrencyValues(currencies)))
+}
+
+// AddFloat adds float value if it is specified.
+func AddFloat(query url.Values, key string, value *float64) {
+	if value == nil {
+		return
+	}
+
+	query.Add(key, strconv.FormatFloat(*value, 'f', -1, 64))
+}
+
+// AddTime adds time value in RFC3339 format if it is not zero.
+func AddTime(query url.Values, key string, value time.Time) {
+	if value.IsZero() {
+		return
+	}
+
+	query.Add(key, value.UTC().Format(time.RFC3339))
+}
+
+// CurrencyKey returns query key for currencies.
+func CurrencyKey(currencies []currency.Currency) string {
+	if len(currencies) == 0 {
+		return ""
+	}
+
+	if currencies[0].ID != "" {
+		return "id"
+	}
+
+	if currencies[0].Symbol != "" {
+		return "symbol"
+	}
+
+	if currencies[0].Slug != "" {
+		return "slug"
+	}
+
+	return ""
+}
+
+// ConvertKey returns convert query key for currencies.
+func ConvertKey(currencies []currency.Currency) string {
+	if len(currencies) == 0 {
+		return ""
+	}
+
+	if currencies[0].ID != "" {
+		return "convert_id"
+	}
+
+	if currencies[0].Symbol != "" {
+		return "convert"
+	}
+
+	return ""
+}
+
+// MatchedKey returns matched query key for currencies.
+func MatchedKey(currencies []currency.Currency) string {
+	if len(currencies) == 0 {
+		return ""
+	}
+
+	if currencies[0].ID != "" {
+		return "matched_id"
+	}
+
+	if currencies[0].Symbol != "" {
+		return "matched_symbol"
+	}
+
+	return ""
+}
+
+// CurrencyValues returns query values for currencies.
+func CurrencyValues(currencies []currency.Currency) []string {
+	if len(currencies) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(currencies))
+
+	for _, curr := range currencies {
+		if curr.ID != "" {
+			values = append(values, curr.ID)
+
+			continue
+		}
+
+		if curr.Symbol != "" {
+			values = append(values, curr.Symbol)
+
+			continue
+		}
+
+		if curr.Slug != "" {
+			values = append(values, curr.Slug)
+		}
+	}
+
+	return values
+}
+
+// CommaSeparated joins values with comma.
+func CommaSeparated(values []string) string {
+	switch len(values) {
+	case 0:
+		return ""
+	case 1:
+		return values[0]
+	}
+
+	var bufLen int
+
+	bufLen += len(comma)*len(values) - 1
+
+	for _, value := range values {
+		bufLen += len(value)
+	}
+
+	var builder strings.Builder
+
+	builder.Grow(bufLen)
+
+	builder.WriteString(values[0])
+
+	for _, value := range values[1:] {
+		builder.WriteString(comma)
+		builder.WriteString(value)
+	}
+
+	return builder.String()
+}
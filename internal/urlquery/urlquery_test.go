@@ -0,0 +1,132 @@
+package urlquery_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/coinmarketcap/currency"
+	"github.com/Mikhalevich/coinmarketcap/internal/urlquery"
+)
+
+func TestAddCurrencies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		add        func(query url.Values, currencies []currency.Currency)
+		currencies []currency.Currency
+		expected   string
+	}{
+		{
+			name:       "currencies by id",
+			add:        urlquery.AddCurrencies,
+			currencies: []currency.Currency{currency.ID(1), currency.ID(1027)},
+			expected:   "id=1%2C1027",
+		},
+		{
+			name:       "currencies by symbol",
+			add:        urlquery.AddCurrencies,
+			currencies: []currency.Currency{currency.Symbol("BTC"), currency.Symbol("ETH")},
+			expected:   "symbol=BTC%2CETH",
+		},
+		{
+			name:       "currencies by slug",
+			add:        urlquery.AddCurrencies,
+			currencies: []currency.Currency{currency.Slug("bitcoin"), currency.Slug("ethereum")},
+			expected:   "slug=bitcoin%2Cethereum",
+		},
+		{
+			name:     "empty currencies",
+			add:      urlquery.AddCurrencies,
+			expected: "",
+		},
+		{
+			name:       "convert by id",
+			add:        urlquery.AddConvert,
+			currencies: []currency.Currency{currency.ID(2781)},
+			expected:   "convert_id=2781",
+		},
+		{
+			name:       "convert by symbol",
+			add:        urlquery.AddConvert,
+			currencies: []currency.Currency{currency.Symbol("USD"), currency.Symbol("EUR")},
+			expected:   "convert=USD%2CEUR",
+		},
+		{
+			name:       "convert by slug",
+			add:        urlquery.AddConvert,
+			currencies: []currency.Currency{currency.Slug("bitcoin")},
+			expected:   "",
+		},
+		{
+			name:     "empty convert",
+			add:      urlquery.AddConvert,
+			expected: "",
+		},
+		{
+			name:       "matched by id",
+			add:        urlquery.AddMatched,
+			currencies: []currency.Currency{currency.ID(825)},
+			expected:   "matched_id=825",
+		},
+		{
+			name:       "matched by symbol",
+			add:        urlquery.AddMatched,
+			currencies: []currency.Currency{currency.Symbol("USDT")},
+			expected:   "matched_symbol=USDT",
+		},
+		{
+			name:       "matched by slug",
+			add:        urlquery.AddMatched,
+			currencies: []currency.Currency{currency.Slug("tether")},
+			expected:   "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			query := make(url.Values)
+			tc.add(query, tc.currencies)
+
+			require.Equal(t, tc.expected, query.Encode())
+		})
+	}
+}
+
+func TestAddFloat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = make(url.Values)
+		value = 0.5
+	)
+
+	urlquery.AddFloat(query, "missing", nil)
+	urlquery.AddFloat(query, "price_min", &value)
+
+	require.Equal(t, "price_min=0.5", query.Encode())
+}
+
+func TestAddTime(t *testing.T) {
+	t.Parallel()
+
+	query := make(url.Values)
+
+	urlquery.AddTime(query, "missing", time.Time{})
+	urlquery.AddTime(query, "time_start", time.Date(2025, time.June, 27, 3, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)))
+
+	require.Equal(t, "time_start=2025-06-27T00%3A00%3A00Z", query.Encode())
+}
+
+func TestCommaSeparated(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, urlquery.CommaSeparated(nil))
+	require.Equal(t, "BTC", urlquery.CommaSeparated([]string{"BTC"}))
+	require.Equal(t, "BTC,ETH,LTC", urlquery.CommaSeparated([]string{"BTC", "ETH", "LTC"}))
+}
@@ -0,0 +1,213 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/key"
+)
+
+type Executor interface {
+	Get(
+		ctx context.Context,
+		path string,
+		preProcessFn func(req *http.Request) error,
+		result any,
+	) error
+}
+
+// Limiter executor decorator limiting requests rate with token bucket.
+// Safe for concurrent use.
+type Limiter struct {
+	executor Executor
+	interval time.Duration
+	burst    float64
+	clock    coinmarketcap.Clock
+	queue    atomic.Int64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+type options struct {
+	Burst  int
+	Tokens *int
+	Clock  coinmarketcap.Clock
+}
+
+// Option limiter optional param.
+type Option func(opts *options)
+
+// WithBurst maximum number of requests executed at once without waiting.
+// Default 1.
+func WithBurst(burst int) Option {
+	return func(opts *options) {
+		opts.Burst = burst
+	}
+}
+
+// WithClock time source, could be replaced in tests.
+// Default real time.
+func WithClock(clock coinmarketcap.Clock) Option {
+	return func(opts *options) {
+		opts.Clock = clock
+	}
+}
+
+func withTokens(tokens int) Option {
+	return func(opts *options) {
+		opts.Tokens = &tokens
+	}
+}
+
+// New construct limiter allowing requestsPerMinute requests per minute through executor.
+func New(executor Executor, requestsPerMinute int, opts ...Option) *Limiter {
+	options := options{
+		Burst: 1,
+		Clock: coinmarketcap.SystemClock{},
+	}
+
+	for _, option := range opts {
+		option(&options)
+	}
+
+	burst := float64(max(options.Burst, 1))
+	tokens := burst
+
+	if options.Tokens != nil {
+		tokens = min(max(float64(*options.Tokens), 0), burst)
+	}
+
+	return &Limiter{
+		executor: executor,
+		interval: time.Minute / time.Duration(max(requestsPerMinute, 1)),
+		burst:    burst,
+		clock:    options.Clock,
+		tokens:   tokens,
+		last:     options.Clock.Now(),
+	}
+}
+
+// FromKeyInfo construct limiter with rate limit of the api key plan.
+// Requests already made in the current minute are taken into account,
+// requests left reported by the key info are clamped to [0, burst].
+func FromKeyInfo(ctx context.Context, executor Executor, opts ...Option) (*Limiter, error) {
+	info, err := key.New(executor).Info(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("key info: %w", err)
+	}
+
+	if info.Data.Plan.RateLimitMinute <= 0 {
+		return nil, fmt.Errorf("invalid plan rate limit: %v", info.Data.Plan.RateLimitMinute)
+	}
+
+	opts = append(opts, withTokens(int(info.Data.Usage.CurrentMinute.RequestsLeft)))
+
+	return New(executor, int(info.Data.Plan.RateLimitMinute), opts...), nil
+}
+
+// Get waits for rate limit token and executes request.
+// Executor errors are returned as is.
+func (l *Limiter) Get(
+	ctx context.Context,
+	path string,
+	preProcessFn func(req *http.Request) error,
+	result any,
+) error {
+	if err := l.Wait(ctx); err != nil {
+		return fmt.Errorf("rate limit wait: %w", err)
+	}
+
+	//nolint:wrapcheck
+	return l.executor.Get(ctx, path, preProcessFn, result)
+}
+
+// Wait blocks until token is available or context is done.
+// Returns error without waiting if context deadline expires before token is available.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context done: %w", err)
+	}
+
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && l.clock.Now().Add(delay).After(deadline) {
+		l.release()
+
+		return fmt.Errorf("wait %s exceeds deadline: %w", delay, context.DeadlineExceeded)
+	}
+
+	l.queue.Add(1)
+	defer l.queue.Add(-1)
+
+	select {
+	case <-ctx.Done():
+		l.release()
+
+		return fmt.Errorf("context done: %w", ctx.Err())
+	case <-l.clock.After(delay):
+		return nil
+	}
+}
+
+// CurrentWait returns how long a new request would wait for a token.
+func (l *Limiter) CurrentWait() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.clock.Now())
+
+	if l.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) * float64(l.interval))
+}
+
+// QueueDepth returns the number of callers waiting for a token.
+func (l *Limiter) QueueDepth() int {
+	return int(l.queue.Load())
+}
+
+// reserve takes token and returns delay until it becomes available.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.clock.Now())
+
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// release returns reserved but not used token.
+func (l *Limiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = min(l.tokens+1, l.burst)
+}
+
+func (l *Limiter) advance(now time.Time) {
+	elapsed := now.Sub(l.last)
+	if elapsed <= 0 {
+		return
+	}
+
+	l.tokens = min(l.tokens+float64(elapsed)/float64(l.interval), l.burst)
+	l.last = now
+}
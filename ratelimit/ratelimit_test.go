@@ -0,0 +1,265 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap/api/key"
+	"github.com/Mikhalevich/coinmarketcap/ratelimit"
+)
+
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	delays []time.Duration
+	fire   chan time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// After advances clock immediately unless fire channel is set.
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.delays = append(f.delays, d)
+
+	if f.fire != nil {
+		return f.fire
+	}
+
+	f.now = f.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- f.now
+
+	return ch
+}
+
+func (f *fakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+}
+
+func TestWait(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = ratelimit.NewMockExecutor(ctrl)
+		clock        = &fakeClock{}
+		limiter      = ratelimit.New(mockExecutor, 30, ratelimit.WithBurst(2), ratelimit.WithClock(clock))
+	)
+
+	require.Zero(t, limiter.CurrentWait())
+
+	for range 4 {
+		require.NoError(t, limiter.Wait(t.Context()))
+	}
+
+	require.Equal(t, []time.Duration{time.Second * 2, time.Second * 2}, clock.delays)
+
+	clock.Advance(time.Second)
+	require.Equal(t, time.Second, limiter.CurrentWait())
+
+	clock.Advance(time.Second * 10)
+	require.Zero(t, limiter.CurrentWait())
+}
+
+func TestWaitDeadline(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = ratelimit.NewMockExecutor(ctrl)
+		clock        = &fakeClock{now: time.Now()}
+		limiter      = ratelimit.New(mockExecutor, 1, ratelimit.WithClock(clock))
+	)
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second*30)
+	defer cancel()
+
+	require.NoError(t, limiter.Wait(ctx))
+	require.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+	require.Empty(t, clock.delays)
+	require.Equal(t, time.Minute, limiter.CurrentWait())
+}
+
+func TestWaitCanceledQueueDepth(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = ratelimit.NewMockExecutor(ctrl)
+		clock        = &fakeClock{fire: make(chan time.Time)}
+		limiter      = ratelimit.New(mockExecutor, 60, ratelimit.WithClock(clock))
+		ctx, cancel  = context.WithCancel(t.Context())
+		errs         = make(chan error, 2)
+	)
+
+	defer cancel()
+
+	require.NoError(t, limiter.Wait(ctx))
+
+	for range 2 {
+		go func() {
+			errs <- limiter.Wait(ctx)
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return limiter.QueueDepth() == 2
+	}, time.Second, time.Millisecond)
+
+	require.Equal(t, time.Second*3, limiter.CurrentWait())
+
+	cancel()
+
+	for range 2 {
+		require.ErrorIs(t, <-errs, context.Canceled)
+	}
+
+	require.Zero(t, limiter.QueueDepth())
+	require.Equal(t, time.Second, limiter.CurrentWait())
+}
+
+func TestGetExecutorError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = ratelimit.NewMockExecutor(ctrl)
+		limiter      = ratelimit.New(mockExecutor, 60, ratelimit.WithClock(&fakeClock{}))
+		executorErr  = errors.New("some executor error")
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/cryptocurrency/map", gomock.Any(), gomock.Any()).
+		Return(executorErr)
+
+	require.Equal(t, executorErr, limiter.Get(t.Context(), "/v1/cryptocurrency/map", nil, nil))
+}
+
+func TestFromKeyInfo(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl         = gomock.NewController(t)
+		mockExecutor = ratelimit.NewMockExecutor(ctrl)
+		clock        = &fakeClock{}
+	)
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/key/info", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context,
+			path string,
+			preProcessFn func(req *http.Request) error,
+			result any,
+		) error {
+			rsp, ok := result.(*key.KeyResponse)
+			require.True(t, ok)
+
+			rsp.Data.Plan.RateLimitMinute = 30
+			rsp.Data.Usage.CurrentMinute.RequestsLeft = 0
+
+			return nil
+		})
+
+	limiter, err := ratelimit.FromKeyInfo(t.Context(), mockExecutor, ratelimit.WithBurst(5), ratelimit.WithClock(clock))
+	require.NoError(t, err)
+	require.Equal(t, time.Second*2, limiter.CurrentWait())
+
+	mockExecutor.EXPECT().
+		Get(t.Context(), "/v1/cryptocurrency/map", gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	require.NoError(t, limiter.Get(t.Context(), "/v1/cryptocurrency/map", nil, nil))
+	require.Equal(t, []time.Duration{time.Second * 2}, clock.delays)
+}
+
+func TestFromKeyInfoClampRequestsLeft(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		requestsLeft float64
+		requests     int
+		expectedWait time.Duration
+	}{
+		{
+			name:         "negative",
+			requestsLeft: -3,
+			expectedWait: time.Second * 2,
+		},
+		{
+			name:         "over burst",
+			requestsLeft: 100,
+			requests:     5,
+			expectedWait: time.Second * 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctrl         = gomock.NewController(t)
+				mockExecutor = ratelimit.NewMockExecutor(ctrl)
+				clock        = &fakeClock{}
+			)
+
+			mockExecutor.EXPECT().
+				Get(t.Context(), "/v1/key/info", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(
+					ctx context.Context,
+					path string,
+					preProcessFn func(req *http.Request) error,
+					result any,
+				) error {
+					rsp, ok := result.(*key.KeyResponse)
+					require.True(t, ok)
+
+					rsp.Data.Plan.RateLimitMinute = 30
+					rsp.Data.Usage.CurrentMinute.RequestsLeft = tc.requestsLeft
+
+					return nil
+				})
+
+			mockExecutor.EXPECT().
+				Get(t.Context(), "/v1/cryptocurrency/map", gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(tc.requests)
+
+			limiter, err := ratelimit.FromKeyInfo(
+				t.Context(),
+				mockExecutor,
+				ratelimit.WithBurst(5),
+				ratelimit.WithClock(clock),
+			)
+			require.NoError(t, err)
+
+			for range tc.requests {
+				require.NoError(t, limiter.Get(t.Context(), "/v1/cryptocurrency/map", nil, nil))
+			}
+
+			require.Empty(t, clock.delays)
+			require.Equal(t, tc.expectedWait, limiter.CurrentWait())
+		})
+	}
+}
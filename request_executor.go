@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+
+	"github.com/Mikhalevich/coinmarketcap/api/types"
 )
 
 const (
@@ -13,8 +15,8 @@ const (
 )
 
 // ProductionExecutor constructs production request executor with https://pro-api.coinmarketcap.com base url.
-func ProductionExecutor(apiKey string, doer HTTPDoer) *RequestExecutor {
-	return NewRequestExecutor(apiKey, productionHost, doer)
+func ProductionExecutor(apiKey string, doer HTTPDoer, opts ...ExecutorOption) *RequestExecutor {
+	return NewRequestExecutor(apiKey, productionHost, doer, opts...)
 }
 
 // HTTPDoer interface for external implementation for doint http request.
@@ -24,18 +26,36 @@ type HTTPDoer interface {
 
 // RequestExecutor structure for raw request executing for coinmarketcap api.
 type RequestExecutor struct {
-	apiKey string
-	host   string
-	doer   HTTPDoer
+	apiKey      string
+	host        string
+	doer        HTTPDoer
+	retryPolicy RetryPolicy
+}
+
+// ExecutorOption request executor optional param.
+type ExecutorOption func(re *RequestExecutor)
+
+// WithRetryPolicy retry failed requests according to policy.
+// By default requests are not retried.
+func WithRetryPolicy(policy RetryPolicy) ExecutorOption {
+	return func(re *RequestExecutor) {
+		re.retryPolicy = policy
+	}
 }
 
 // NewRequestExecutor construct new request executor.
-func NewRequestExecutor(apiKey string, host string, doer HTTPDoer) *RequestExecutor {
-	return &RequestExecutor{
+func NewRequestExecutor(apiKey string, host string, doer HTTPDoer, opts ...ExecutorOption) *RequestExecutor {
+	executor := &RequestExecutor{
 		apiKey: apiKey,
 		host:   host,
 		doer:   doer,
 	}
+
+	for _, option := range opts {
+		option(executor)
+	}
+
+	return executor
 }
 
 // Get execute Get request for specified endpoint path.
@@ -64,16 +84,35 @@ func (re *RequestExecutor) Get(
 		return fmt.Errorf("pre process: %w", err)
 	}
 
-	rsp, err := re.doer.Do(req)
+	rsp, err := re.doWithRetry(req)
 	if err != nil {
 		return fmt.Errorf("do http request: %w", err)
 	}
 
 	defer rsp.Body.Close()
 
+	if rsp.StatusCode >= http.StatusBadRequest {
+		return makeHTTPError(rsp)
+	}
+
 	if err := json.NewDecoder(rsp.Body).Decode(result); err != nil {
 		return fmt.Errorf("json decode: %w", err)
 	}
 
 	return nil
 }
+
+// makeHTTPError makes error from unsuccessful response.
+// Api error code and message are taken from response status if body contains one,
+// error code could be either number or string.
+func makeHTTPError(rsp *http.Response) *Error {
+	var body struct {
+		Status types.Status `json:"status"`
+	}
+
+	if err := json.NewDecoder(rsp.Body).Decode(&body); err != nil || body.Status.ErrorMessage == "" {
+		return NewHTTPError(rsp.StatusCode, body.Status.ErrorCode, http.StatusText(rsp.StatusCode))
+	}
+
+	return NewHTTPError(rsp.StatusCode, body.Status.ErrorCode, body.Status.ErrorMessage)
+}
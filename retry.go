@@ -0,0 +1,203 @@
+package coinmarketcap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryBaseDelay   = time.Millisecond * 500
+	defaultRetryMaxDelay    = time.Second * 10
+)
+
+// Clock time source used by retry policy, could be replaced in tests.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock real time source.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// RetryPolicy describes how failed requests are retried.
+// Zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts total number of attempts including the first one.
+	MaxAttempts int
+	// BaseDelay backoff delay before the first retry, doubled for every next retry.
+	BaseDelay time.Duration
+	// MaxDelay upper bound of backoff delay, Retry-After header is not limited by it.
+	MaxDelay time.Duration
+	// Retryable reports whether request should be retried, DefaultRetryable is used if nil.
+	Retryable func(rsp *http.Response, err error) bool
+	// Jitter randomizes backoff delay, full jitter in [0, delay) is used if nil.
+	Jitter func(delay time.Duration) time.Duration
+	// Clock time source, real time is used if nil.
+	Clock Clock
+}
+
+// DefaultRetryPolicy returns policy with 3 attempts and exponential backoff starting from 500ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		BaseDelay:   defaultRetryBaseDelay,
+		MaxDelay:    defaultRetryMaxDelay,
+	}
+}
+
+// DefaultRetryable retries transport errors except context cancellation,
+// 429 Too Many Requests caused by rate limit (see IsRetryable, credit limits are not retried)
+// and 5xx responses except 501 Not Implemented and 505 HTTP Version Not Supported.
+// Only GET requests are executed, so all of these failures are safe to repeat.
+func DefaultRetryable(rsp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch {
+	case rsp.StatusCode == http.StatusTooManyRequests:
+		return IsRetryable(peekHTTPError(rsp))
+	case rsp.StatusCode == http.StatusNotImplemented,
+		rsp.StatusCode == http.StatusHTTPVersionNotSupported:
+		return false
+	case rsp.StatusCode >= http.StatusInternalServerError:
+		return true
+	}
+
+	return false
+}
+
+// peekHTTPError makes error from unsuccessful response keeping response body readable.
+func peekHTTPError(rsp *http.Response) *Error {
+	body, _ := io.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	rsp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return makeHTTPError(&http.Response{
+		StatusCode: rsp.StatusCode,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	})
+}
+
+func (p RetryPolicy) retryable(rsp *http.Response, err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(rsp, err)
+	}
+
+	return DefaultRetryable(rsp, err)
+}
+
+func (p RetryPolicy) clock() Clock {
+	if p.Clock != nil {
+		return p.Clock
+	}
+
+	return SystemClock{}
+}
+
+// delay returns delay before the next attempt, attempt is 1-based number of the failed attempt.
+func (p RetryPolicy) delay(attempt int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if retryAfter, ok := parseRetryAfter(rsp.Header.Get("Retry-After"), p.clock().Now()); ok {
+			return retryAfter
+		}
+	}
+
+	delay := p.BaseDelay << (attempt - 1)
+	if p.MaxDelay > 0 && (delay > p.MaxDelay || delay <= 0) {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter != nil {
+		return p.Jitter(delay)
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	//nolint:gosec
+	return time.Duration(rand.Int64N(int64(delay)))
+}
+
+// exceedsDeadline reports whether context deadline expires before delay.
+func (p RetryPolicy) exceedsDeadline(ctx context.Context, delay time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+
+	return ok && p.clock().Now().Add(delay).After(deadline)
+}
+
+// wait blocks for delay or until context is done.
+func (p RetryPolicy) wait(ctx context.Context, delay time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("wait retry: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("wait retry: %w", ctx.Err())
+	case <-p.clock().After(delay):
+		return nil
+	}
+}
+
+// parseRetryAfter parses Retry-After header value in seconds or http date format.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(date.Sub(now), 0), true
+}
+
+// doWithRetry executes request retrying failures according to retry policy.
+// Response or error of the last attempt is returned if no retries left.
+func (re *RequestExecutor) doWithRetry(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		rsp, err := re.doer.Do(req)
+
+		if attempt >= re.retryPolicy.MaxAttempts || !re.retryPolicy.retryable(rsp, err) {
+			return rsp, err
+		}
+
+		delay := re.retryPolicy.delay(attempt, rsp)
+
+		if re.retryPolicy.exceedsDeadline(req.Context(), delay) {
+			return rsp, err
+		}
+
+		if rsp != nil {
+			_, _ = io.Copy(io.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+
+		if err := re.retryPolicy.wait(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package coinmarketcap_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/coinmarketcap"
+	"github.com/Mikhalevich/coinmarketcap/api/cryptocurrency"
+)
+
+type fakeClock struct {
+	now    time.Time
+	delays []time.Duration
+}
+
+func (f *fakeClock) Now() time.Time {
+	return f.now
+}
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.delays = append(f.delays, d)
+	f.now = f.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- f.now
+
+	return ch
+}
+
+func makeResponse(statusCode int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func testRetryPolicy(clock coinmarketcap.Clock) coinmarketcap.RetryPolicy {
+	return coinmarketcap.RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   time.Second,
+		MaxDelay:    time.Second * 3,
+		Jitter: func(delay time.Duration) time.Duration {
+			return delay
+		},
+		Clock: clock,
+	}
+}
+
+func getInfo(ctx context.Context, executor *coinmarketcap.RequestExecutor) error {
+	var rsp cryptocurrency.InfoResponse
+
+	return executor.Get(
+		ctx,
+		"some_path",
+		func(req *http.Request) error {
+			return nil
+		},
+		&rsp,
+	)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl     = gomock.NewController(t)
+		doer     = coinmarketcap.NewMockHTTPDoer(ctrl)
+		clock    = &fakeClock{now: time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC)}
+		executor = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer,
+			coinmarketcap.WithRetryPolicy(testRetryPolicy(clock)))
+	)
+
+	gomock.InOrder(
+		doer.EXPECT().Do(gomock.Any()).Return(nil, errors.New("connection reset")),
+		doer.EXPECT().Do(gomock.Any()).Return(makeResponse(http.StatusBadGateway, nil, "bad gateway"), nil),
+		doer.EXPECT().Do(gomock.Any()).Return(makeResponse(http.StatusServiceUnavailable, nil, ""), nil),
+		doer.EXPECT().Do(gomock.Any()).Return(makeResponse(http.StatusOK, nil, successResponseBody), nil),
+	)
+
+	require.NoError(t, getInfo(t.Context(), executor))
+	require.Equal(t, []time.Duration{time.Second, time.Second * 2, time.Second * 3}, clock.delays)
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl     = gomock.NewController(t)
+		doer     = coinmarketcap.NewMockHTTPDoer(ctrl)
+		now      = time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC)
+		clock    = &fakeClock{now: now}
+		executor = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer,
+			coinmarketcap.WithRetryPolicy(testRetryPolicy(clock)))
+	)
+
+	gomock.InOrder(
+		doer.EXPECT().Do(gomock.Any()).Return(
+			makeResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"7"}}, ""), nil),
+		doer.EXPECT().Do(gomock.Any()).Return(
+			makeResponse(http.StatusTooManyRequests, http.Header{
+				"Retry-After": []string{now.Add(time.Second * 17).Format(http.TimeFormat)},
+			}, ""), nil),
+		doer.EXPECT().Do(gomock.Any()).Return(makeResponse(http.StatusOK, nil, successResponseBody), nil),
+	)
+
+	require.NoError(t, getInfo(t.Context(), executor))
+	require.Equal(t, []time.Duration{time.Second * 7, time.Second * 10}, clock.delays)
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl     = gomock.NewController(t)
+		doer     = coinmarketcap.NewMockHTTPDoer(ctrl)
+		clock    = &fakeClock{}
+		executor = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer,
+			coinmarketcap.WithRetryPolicy(testRetryPolicy(clock)))
+	)
+
+	doer.EXPECT().Do(gomock.Any()).Return(makeResponse(http.StatusUnauthorized, nil, successResponseBody), nil)
+
+	require.ErrorIs(t, getInfo(t.Context(), executor), coinmarketcap.ErrInvalidAPIKey)
+	require.Empty(t, clock.delays)
+}
+
+func TestRetryRateLimitCode(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl     = gomock.NewController(t)
+		doer     = coinmarketcap.NewMockHTTPDoer(ctrl)
+		clock    = &fakeClock{}
+		executor = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer,
+			coinmarketcap.WithRetryPolicy(testRetryPolicy(clock)))
+	)
+
+	gomock.InOrder(
+		doer.EXPECT().Do(gomock.Any()).Return(makeResponse(http.StatusTooManyRequests, nil,
+			`{"status":{"error_code":1008,"error_message":"minute rate limit"}}`), nil),
+		doer.EXPECT().Do(gomock.Any()).Return(makeResponse(http.StatusOK, nil, successResponseBody), nil),
+	)
+
+	require.NoError(t, getInfo(t.Context(), executor))
+	require.Equal(t, []time.Duration{time.Second}, clock.delays)
+}
+
+func TestRetryCreditLimitNotRetryable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl     = gomock.NewController(t)
+		doer     = coinmarketcap.NewMockHTTPDoer(ctrl)
+		clock    = &fakeClock{}
+		executor = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer,
+			coinmarketcap.WithRetryPolicy(testRetryPolicy(clock)))
+	)
+
+	doer.EXPECT().Do(gomock.Any()).Return(makeResponse(http.StatusTooManyRequests, nil,
+		`{"status":{"error_code":1010,"error_message":"monthly credit limit"}}`), nil)
+
+	err := getInfo(t.Context(), executor)
+	require.EqualError(t, err, "http status: 429 code: 1010 message: monthly credit limit")
+	require.ErrorIs(t, err, coinmarketcap.ErrCreditLimit)
+	require.Empty(t, clock.delays)
+}
+
+func TestRetryAttemptsExhausted(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl     = gomock.NewController(t)
+		doer     = coinmarketcap.NewMockHTTPDoer(ctrl)
+		clock    = &fakeClock{}
+		executor = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer,
+			coinmarketcap.WithRetryPolicy(testRetryPolicy(clock)))
+	)
+
+	doer.EXPECT().Do(gomock.Any()).Return(nil, errors.New("some do error")).Times(4)
+
+	require.EqualError(t, getInfo(t.Context(), executor), "do http request: some do error")
+	require.Len(t, clock.delays, 3)
+}
+
+func TestRetryDeadline(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl     = gomock.NewController(t)
+		doer     = coinmarketcap.NewMockHTTPDoer(ctrl)
+		clock    = &fakeClock{now: time.Now()}
+		executor = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer,
+			coinmarketcap.WithRetryPolicy(testRetryPolicy(clock)))
+	)
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	doer.EXPECT().Do(gomock.Any()).Return(
+		makeResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"120"}}, "too many requests"), nil)
+
+	err := getInfo(ctx, executor)
+	require.EqualError(t, err, "http status: 429 code: 0 message: Too Many Requests")
+	require.True(t, coinmarketcap.IsRateLimited(err))
+	require.Empty(t, clock.delays)
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		doer        = coinmarketcap.NewMockHTTPDoer(ctrl)
+		ctx, cancel = context.WithCancel(t.Context())
+		executor    = coinmarketcap.NewRequestExecutor("testApiKey", "some_host", doer,
+			coinmarketcap.WithRetryPolicy(coinmarketcap.DefaultRetryPolicy()))
+	)
+
+	doer.EXPECT().Do(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+		cancel()
+
+		return makeResponse(http.StatusServiceUnavailable, nil, ""), nil
+	})
+
+	err := getInfo(ctx, executor)
+	require.ErrorIs(t, err, context.Canceled)
+}